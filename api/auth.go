@@ -0,0 +1,389 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	gw "github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// role is the access level of a client. Each role includes the ones below it.
+type role int
+
+const (
+	roleNone role = iota
+	roleReadOnly
+	roleTransact
+	roleDeploy
+	roleAdmin
+)
+
+var roleNames = map[string]role{
+	"read-only": roleReadOnly,
+	"transact":  roleTransact,
+	"deploy":    roleDeploy,
+	"admin":     roleAdmin,
+}
+
+func (r role) String() string {
+	for name, v := range roleNames {
+		if v == r {
+			return name
+		}
+	}
+	return "none"
+}
+
+func parseRole(name string) (role, error) {
+	r, ok := roleNames[name]
+	if !ok {
+		return roleNone, fmt.Errorf("unknown role %q", name)
+	}
+	return r, nil
+}
+
+// methodRoles are the roles required to call the RPCs. Methods which are not
+// listed require the admin role.
+var methodRoles = map[string]role{
+	"/ethereum.Ethereum/Compile":                      roleDeploy,
+	"/ethereum.Ethereum/Deploy":                       roleDeploy,
+	"/ethereum.Ethereum/DeploySource":                 roleDeploy,
+	"/ethereum.Ethereum/RegisterContract":             roleDeploy,
+	"/ethereum.Ethereum/ImportContract":               roleDeploy,
+	"/ethereum.Ethereum/Transact":                     roleTransact,
+	"/ethereum.Ethereum/ListContracts":                roleReadOnly,
+	"/ethereum.Ethereum/GetContract":                  roleReadOnly,
+	"/ethereum.Ethereum/Call":                         roleReadOnly,
+	"/ethereum.Ethereum/EstimateGas":                  roleReadOnly,
+	"/ethereum.Ethereum/EncodeCall":                   roleReadOnly,
+	"/ethereum.Ethereum/DecodeOutput":                 roleReadOnly,
+	"/ethereum.Ethereum/DecodeInput":                  roleReadOnly,
+	"/ethereum.Ethereum/DecodeLog":                    roleReadOnly,
+	"/ethereum.Ethereum/GetBalance":                   roleReadOnly,
+	"/ethereum.Ethereum/GetNonce":                     roleReadOnly,
+	"/ethereum.Ethereum/GetCode":                      roleReadOnly,
+	"/ethereum.Ethereum/GetStorageAt":                 roleReadOnly,
+	"/ethereum.Ethereum/GetTransaction":               roleReadOnly,
+	"/ethereum.Ethereum/GetTransactionReceipt":        roleReadOnly,
+	"/ethereum.Ethereum/GetBlock":                     roleReadOnly,
+	"/ethereum.Ethereum/GetChainHead":                 roleReadOnly,
+	"/ethereum.Ethereum/SubscribeLogs":                roleReadOnly,
+	"/ethereum.Ethereum/SubscribeNewHeads":            roleReadOnly,
+	"/ethereum.Ethereum/SubscribePendingTransactions": roleReadOnly,
+	"/ethereum.Accounts/ListAccounts":                 roleReadOnly,
+}
+
+const (
+	// Metadata the gateway passes on the role of its REST clients with. The
+	// gateway forwards Grpc-Metadata- prefixed headers as metadata, and the
+	// Authorization header as is.
+	gatewaySecretKey    = "ethermis-gateway"
+	gatewayRoleKey      = "ethermis-role"
+	gatewaySecretHeader = "Grpc-Metadata-Ethermis-Gateway"
+	gatewayRoleHeader   = "Grpc-Metadata-Ethermis-Role"
+	authorizationKey    = "authorization"
+)
+
+// principal is an authenticated client.
+type principal struct {
+	role      role
+	accounts  []string // Accounts it may send transactions from, any if empty
+	contracts []string // Contracts it may invoke, any if empty
+}
+
+// authorizer authenticates clients by their verified certificates or bearer
+// tokens and enforces their roles on every RPC. REST clients are
+// authenticated by the gateway handler, which passes their role on to the
+// interceptors along with a per-process secret.
+type authorizer struct {
+	certs         map[string]role // By certificate common name, "*" for any other, nil if not verified
+	tokens        *tokenStore     // Nil if token authentication is disabled
+	contracts     contractResolver
+	gatewaySecret string
+}
+
+// newAuthorizer creates an authorizer for the enabled authentication methods.
+// Certificate roles are loaded from rolesFile, a JSON object of common names
+// to role names. Without a file, every verified client is an admin.
+func newAuthorizer(verifyCerts bool, rolesFile string, tokens *tokenStore, contracts contractResolver) (*authorizer, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	a := &authorizer{
+		tokens:        tokens,
+		contracts:     contracts,
+		gatewaySecret: hex.EncodeToString(secret),
+	}
+	if !verifyCerts {
+		return a, nil
+	}
+	if rolesFile == "" {
+		a.certs = map[string]role{"*": roleAdmin}
+		return a, nil
+	}
+
+	data, err := ioutil.ReadFile(rolesFile)
+	if err != nil {
+		return nil, err
+	}
+	var names map[string]string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, fmt.Errorf("invalid roles file %s: %v", rolesFile, err)
+	}
+	a.certs = make(map[string]role)
+	for subject, name := range names {
+		if a.certs[subject], err = parseRole(name); err != nil {
+			return nil, fmt.Errorf("subject %q: %v", subject, err)
+		}
+	}
+	return a, nil
+}
+
+// certRole returns the role of the verified client certificate of a
+// connection.
+func (a *authorizer) certRole(info credentials.TLSInfo) role {
+	chains := info.State.VerifiedChains
+	if a.certs == nil || len(chains) == 0 || len(chains[0]) == 0 {
+		return roleNone
+	}
+	if r, ok := a.certs[chains[0][0].Subject.CommonName]; ok {
+		return r
+	}
+	return a.certs["*"]
+}
+
+// authenticate returns the client of an RPC.
+func (a *authorizer) authenticate(ctx context.Context) (*principal, error) {
+	md, _ := metadata.FromContext(ctx)
+
+	if secret := md[gatewaySecretKey]; len(secret) > 0 {
+		if subtle.ConstantTimeCompare([]byte(secret[0]), []byte(a.gatewaySecret)) != 1 || len(md[gatewayRoleKey]) == 0 {
+			return nil, grpc.Errorf(codes.Unauthenticated, "invalid gateway credentials")
+		}
+		r, err := parseRole(md[gatewayRoleKey][0])
+		if err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "invalid gateway credentials")
+		}
+		return &principal{role: r}, nil
+	}
+
+	if auth := md[authorizationKey]; a.tokens != nil && len(auth) > 0 {
+		token, ok := bearerToken(auth[0])
+		if !ok {
+			return nil, grpc.Errorf(codes.Unauthenticated, "expected bearer token")
+		}
+		record := a.tokens.lookup(token)
+		if record == nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "invalid token")
+		}
+		r, err := parseRole(record.Role)
+		if err != nil {
+			return nil, err
+		}
+		return &principal{
+			role:      r,
+			accounts:  record.Accounts,
+			contracts: record.Contracts,
+		}, nil
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if r := a.certRole(info); r != roleNone {
+				return &principal{role: r}, nil
+			}
+		}
+	}
+	return nil, grpc.Errorf(codes.Unauthenticated, "no authorized client certificate or token")
+}
+
+// authorize checks that the client of an RPC may call method.
+func (a *authorizer) authorize(ctx context.Context, method string) (*principal, error) {
+	p, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	required, ok := methodRoles[method]
+	if !ok {
+		required = roleAdmin
+	}
+	if p.role < required {
+		return nil, grpc.Errorf(codes.PermissionDenied, "%s requires the %s role", method, required)
+	}
+	return p, nil
+}
+
+// checkScope verifies that a request only sends from and invokes the accounts
+// and contracts the client is restricted to. Contract names are resolved to
+// the addresses they point to first.
+func (p *principal) checkScope(ctx context.Context, req interface{}, contracts contractResolver) error {
+	scoped := len(p.accounts) > 0 || len(p.contracts) > 0
+
+	var accounts, refs []string
+	switch req := req.(type) {
+	case *gw.CreateTokenRequest, *gw.RevokeTokenRequest, *gw.ListTokensRequest:
+		// Otherwise a restricted admin could issue itself an unrestricted token
+		if scoped {
+			return grpc.Errorf(codes.PermissionDenied, "restricted tokens cannot manage tokens")
+		}
+	case *gw.ContractInfo, *gw.ImportContractRequest:
+		// Otherwise a restricted client could re-point the names others use
+		if scoped {
+			return grpc.Errorf(codes.PermissionDenied, "restricted tokens cannot register contracts")
+		}
+	case *gw.CompiledContract:
+		accounts = append(accounts, req.GetOptions().GetFrom())
+	case *gw.DeploySourceRequest:
+		accounts = append(accounts, req.GetOptions().GetFrom())
+	case *gw.TransactRequest:
+		accounts = append(accounts, req.GetOptions().GetFrom())
+		refs = append(refs, req.Address)
+	case *gw.CallRequest:
+		refs = append(refs, req.Address)
+	case *gw.LogFilter:
+		// Without addresses, the logs of every contract would match
+		if len(p.contracts) > 0 && len(req.Addresses) == 0 {
+			return grpc.Errorf(codes.PermissionDenied, "addresses must be among %s", strings.Join(p.contracts, ", "))
+		}
+		refs = append(refs, req.Addresses...)
+	case *gw.PendingTransactionsRequest:
+		// Pending transactions of every sender and contract would be revealed
+		if scoped {
+			return grpc.Errorf(codes.PermissionDenied, "restricted tokens cannot watch pending transactions")
+		}
+	case *gw.EstimateGasRequest:
+		if d := req.GetDeploy(); d != nil {
+			accounts = append(accounts, d.GetOptions().GetFrom())
+		}
+		if t := req.GetTransact(); t != nil {
+			accounts = append(accounts, t.GetOptions().GetFrom())
+			refs = append(refs, t.Address)
+		}
+	case *gw.ExportAccountRequest:
+		accounts = append(accounts, req.Address)
+	case *gw.UnlockAccountRequest:
+		accounts = append(accounts, req.Address)
+	case *gw.LockAccountRequest:
+		accounts = append(accounts, req.Address)
+	}
+
+	for _, account := range accounts {
+		if len(p.accounts) > 0 && !inScope(p.accounts, account) {
+			if account == "" {
+				return grpc.Errorf(codes.PermissionDenied, "sender must be one of %s", strings.Join(p.accounts, ", "))
+			}
+			return grpc.Errorf(codes.PermissionDenied, "account %s is out of scope", account)
+		}
+	}
+	for _, ref := range refs {
+		if len(p.contracts) == 0 {
+			break
+		}
+		address, err := resolveContract(ctx, contracts, ref)
+		if err != nil || !inScope(p.contracts, address) {
+			return grpc.Errorf(codes.PermissionDenied, "contract %s is out of scope", ref)
+		}
+	}
+	return nil
+}
+
+// inScope reports whether an account or contract address is in scope.
+func inScope(scope []string, address string) bool {
+	if address == "" {
+		return false
+	}
+	address = normalizeAddress(address)
+	for _, s := range scope {
+		if s == address {
+			return true
+		}
+	}
+	return false
+}
+
+// contractResolver resolves registered contract names, as the controller
+// does.
+type contractResolver interface {
+	GetContract(ctx context.Context, req *gw.GetContractRequest) (*gw.ContractInfo, error)
+}
+
+// resolveContract returns the address a contract address or registered name
+// currently points to.
+func resolveContract(ctx context.Context, contracts contractResolver, ref string) (string, error) {
+	if common.IsHexAddress(ref) {
+		return normalizeAddress(ref), nil
+	}
+	info, err := contracts.GetContract(ctx, &gw.GetContractRequest{Contract: ref})
+	if err != nil {
+		return "", err
+	}
+	return normalizeAddress(info.Address), nil
+}
+
+func (a *authorizer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	p, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkScope(ctx, req, a.contracts); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authorizer) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	p, err := a.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &scopedStream{ServerStream: stream, principal: p, contracts: a.contracts})
+}
+
+// scopedStream checks the requests received on a stream against the scope of
+// its client, as the unary interceptor does.
+type scopedStream struct {
+	grpc.ServerStream
+	principal *principal
+	contracts contractResolver
+}
+
+func (s *scopedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.principal.checkScope(s.Context(), m, s.contracts)
+}
+
+// gatewayHandler authenticates REST clients by their certificates and hands
+// their role to the gateway. Headers forging the role are dropped. Anyone
+// else is left to the interceptors, which accept bearer tokens.
+func (a *authorizer) gatewayHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Del(gatewaySecretHeader)
+		r.Header.Del(gatewayRoleHeader)
+
+		if r.TLS != nil {
+			if role := a.certRole(credentials.TLSInfo{State: *r.TLS}); role != roleNone {
+				r.Header.Set(gatewaySecretHeader, a.gatewaySecret)
+				r.Header.Set(gatewayRoleHeader, role.String())
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	gw "github.com/alanchchen/ethermis/api/ethereum"
+)
+
+const (
+	addrA = "0x00000000000000000000000000000000000000Aa"
+	addrB = "0x00000000000000000000000000000000000000bB"
+	addrC = "0x00000000000000000000000000000000000000Cc"
+	addrD = "0x00000000000000000000000000000000000000dd"
+)
+
+// fakeRegistry resolves contract names from a map.
+type fakeRegistry map[string]string
+
+func (r fakeRegistry) GetContract(ctx context.Context, req *gw.GetContractRequest) (*gw.ContractInfo, error) {
+	address, ok := r[req.Contract]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "contract %q not registered", req.Contract)
+	}
+	return &gw.ContractInfo{Name: req.Contract, Address: address}, nil
+}
+
+func TestCheckScope(t *testing.T) {
+	registry := fakeRegistry{"Token": addrC, "Other": addrD}
+	scoped := &principal{
+		role:      roleTransact,
+		accounts:  []string{normalizeAddress(addrA)},
+		contracts: []string{normalizeAddress(addrC)},
+	}
+	from := func(account string) *gw.TxOptions { return &gw.TxOptions{From: account} }
+
+	tests := []struct {
+		req interface{}
+		ok  bool
+	}{
+		{&gw.TransactRequest{Address: "Token", Options: from(addrA)}, true},
+		{&gw.TransactRequest{Address: strings.ToLower(addrC), Options: from(strings.ToLower(addrA))}, true},
+		{&gw.TransactRequest{Address: "Other", Options: from(addrA)}, false},
+		{&gw.TransactRequest{Address: addrD, Options: from(addrA)}, false},
+		{&gw.TransactRequest{Address: "Missing", Options: from(addrA)}, false},
+		{&gw.TransactRequest{Address: "Token", Options: from(addrB)}, false},
+		{&gw.TransactRequest{Address: "Token"}, false},
+		{&gw.CallRequest{Address: "Token"}, true},
+		{&gw.CallRequest{Address: "Other"}, false},
+		{&gw.CallRequest{}, false},
+		{&gw.EstimateGasRequest{Payload: &gw.EstimateGasRequest_Transact{Transact: &gw.TransactRequest{Address: "Token", Options: from(addrA)}}}, true},
+		{&gw.EstimateGasRequest{Payload: &gw.EstimateGasRequest_Transact{Transact: &gw.TransactRequest{Address: "Other", Options: from(addrA)}}}, false},
+		{&gw.CompiledContract{Options: from(addrA)}, true},
+		{&gw.CompiledContract{Options: from(addrB)}, false},
+		{&gw.UnlockAccountRequest{Address: addrB}, false},
+		{&gw.ContractInfo{Name: "Token", Address: addrB}, false},
+		{&gw.ImportContractRequest{Name: "Token", Address: addrB}, false},
+		{&gw.CreateTokenRequest{Role: "admin"}, false},
+		{&gw.LogFilter{Addresses: []string{strings.ToLower(addrC)}}, true},
+		{&gw.LogFilter{Addresses: []string{addrC, addrD}}, false},
+		{&gw.LogFilter{}, false},
+		{&gw.PendingTransactionsRequest{}, false},
+		{&gw.BlockRequest{}, true},
+	}
+	for i, tt := range tests {
+		err := scoped.checkScope(context.Background(), tt.req, registry)
+		if tt.ok && err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+		}
+		if !tt.ok && grpc.Code(err) != codes.PermissionDenied {
+			t.Errorf("test %d: error mismatch: have %v, want permission denied", i, err)
+		}
+	}
+
+	// Unrestricted clients are never checked
+	unscoped := &principal{role: roleAdmin}
+	for i, tt := range tests {
+		if err := unscoped.checkScope(context.Background(), tt.req, registry); err != nil {
+			t.Errorf("test %d: unexpected error without scope: %v", i, err)
+		}
+	}
+}
+
+func TestCheckScopeRepointedName(t *testing.T) {
+	registry := fakeRegistry{"Token": addrC}
+	p := &principal{role: roleTransact, contracts: []string{normalizeAddress(addrC)}}
+
+	req := &gw.CallRequest{Address: "Token"}
+	if err := p.checkScope(context.Background(), req, registry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	registry["Token"] = addrB
+	if err := p.checkScope(context.Background(), req, registry); grpc.Code(err) != codes.PermissionDenied {
+		t.Fatalf("error mismatch for re-pointed name: have %v, want permission denied", err)
+	}
+}
+
+// fakeServerStream receives a single request.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *gw.LogFilter
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	*m.(*gw.LogFilter) = *s.req
+	return nil
+}
+
+func TestStreamInterceptorScope(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tokens, err := openTokenStore(filepath.Join(dir, tokenFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, err := tokens.create("bot", roleReadOnly, nil, []string{normalizeAddress(addrC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := newAuthorizer(false, "", tokens, fakeRegistry{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := metadata.NewContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer "+token))
+	info := &grpc.StreamServerInfo{FullMethod: "/ethereum.Ethereum/SubscribeLogs"}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(new(gw.LogFilter))
+	}
+
+	tests := []struct {
+		addresses []string
+		ok        bool
+	}{
+		{[]string{addrC}, true},
+		{[]string{addrD}, false},
+		{nil, false},
+	}
+	for i, tt := range tests {
+		stream := &fakeServerStream{ctx: ctx, req: &gw.LogFilter{Addresses: tt.addresses}}
+		err := a.streamInterceptor(nil, stream, info, handler)
+		if tt.ok && err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+		}
+		if !tt.ok && grpc.Code(err) != codes.PermissionDenied {
+			t.Errorf("test %d: error mismatch: have %v, want permission denied", i, err)
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tokens, err := openTokenStore(filepath.Join(dir, tokenFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, err := tokens.create("bot", roleTransact, []string{normalizeAddress(addrA)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := newAuthorizer(true, "", tokens, fakeRegistry{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.certs = map[string]role{"alice": roleDeploy, "*": roleReadOnly}
+
+	withMD := func(pairs ...string) context.Context {
+		return metadata.NewContext(context.Background(), metadata.Pairs(pairs...))
+	}
+	withCert := func(name string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: name}}
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			}},
+		})
+	}
+
+	tests := []struct {
+		ctx      context.Context
+		role     role
+		accounts int
+	}{
+		{context.Background(), roleNone, 0},
+		{withMD(authorizationKey, "Bearer "+token), roleTransact, 1},
+		{withMD(authorizationKey, "bearer "+token), roleTransact, 1},
+		{withMD(authorizationKey, "Basic "+token), roleNone, 0},
+		{withMD(authorizationKey, "Bearer invalid"), roleNone, 0},
+		{withMD(gatewaySecretKey, a.gatewaySecret, gatewayRoleKey, "deploy"), roleDeploy, 0},
+		{withMD(gatewaySecretKey, "forged", gatewayRoleKey, "admin"), roleNone, 0},
+		{withMD(gatewaySecretKey, a.gatewaySecret), roleNone, 0},
+		{withCert("alice"), roleDeploy, 0},
+		{withCert("bob"), roleReadOnly, 0},
+	}
+	for i, tt := range tests {
+		p, err := a.authenticate(tt.ctx)
+		if tt.role == roleNone {
+			if grpc.Code(err) != codes.Unauthenticated {
+				t.Errorf("test %d: error mismatch: have %v, want unauthenticated", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+			continue
+		}
+		if p.role != tt.role || len(p.accounts) != tt.accounts {
+			t.Errorf("test %d: principal mismatch: have %s with %d accounts, want %s with %d", i, p.role, len(p.accounts), tt.role, tt.accounts)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	a := &authorizer{certs: map[string]role{"*": roleTransact}}
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}},
+	})
+
+	tests := []struct {
+		method string
+		ok     bool
+	}{
+		{"/ethereum.Ethereum/Call", true},
+		{"/ethereum.Ethereum/Transact", true},
+		{"/ethereum.Ethereum/Deploy", false},
+		{"/ethereum.Ethereum/RegisterContract", false},
+		{"/ethereum.Accounts/NewAccount", false},
+		{"/ethereum.Tokens/CreateToken", false},
+	}
+	for _, tt := range tests {
+		_, err := a.authorize(ctx, tt.method)
+		if tt.ok && err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.method, err)
+		}
+		if !tt.ok && grpc.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s: error mismatch: have %v, want permission denied", tt.method, err)
+		}
+	}
+}
@@ -1,21 +1,38 @@
 package api
 
 import (
-	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/alanchchen/ethermis/api/ethereum"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 )
 
 func DeployContract(cmd *cobra.Command, args []string) {
-	var opts []grpc.DialOption
-	creds := credentials.NewClientTLSFromCert(demoCertPool, "localhost:10000")
-	opts = append(opts, grpc.WithTransportCredentials(creds))
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", host, port), opts...)
+	var dataDir string
+	if f := cmd.Flag("datadir"); f != nil {
+		dataDir = f.Value.String()
+	}
+	dopt, err := dialOption(dataDir)
+	if err != nil {
+		grpclog.Fatalf("fail to load credentials: %v", err)
+	}
+
+	dopts := []grpc.DialOption{dopt}
+	if clientToken != "" {
+		dopts = append(dopts, grpc.WithPerRPCCredentials(tokenCredentials(clientToken)))
+	}
+	address := net.JoinHostPort(serverName(), strconv.Itoa(port))
+	switch {
+	case grpcSocket != "":
+		address, dopts = grpcSocket, append(dopts, grpc.WithDialer(dialUnix))
+	case grpcPort > 0:
+		address = net.JoinHostPort(serverName(), strconv.Itoa(grpcPort))
+	}
+	conn, err := grpc.Dial(address, dopts...)
 	if err != nil {
 		grpclog.Fatalf("fail to dial: %v", err)
 	}
@@ -25,6 +42,7 @@ func DeployContract(cmd *cobra.Command, args []string) {
 	msg, err := client.Deploy(context.Background(), &ethereum.CompiledContract{
 		Abi:  args[0],
 		Code: args[1],
+		Args: args[2:],
 	})
 
 	if err != nil {
@@ -10,6 +10,39 @@ import (
 
 type Controller interface {
 	Deploy(ctx context.Context, contract *ethereum.CompiledContract) (*ethereum.DeploymentInfo, error)
+	Compile(ctx context.Context, req *ethereum.CompileRequest) (*ethereum.CompileResult, error)
+	DeploySource(ctx context.Context, req *ethereum.DeploySourceRequest) (*ethereum.DeploymentInfo, error)
+	RegisterContract(ctx context.Context, info *ethereum.ContractInfo) (*ethereum.ContractInfo, error)
+	ImportContract(ctx context.Context, req *ethereum.ImportContractRequest) (*ethereum.ContractInfo, error)
+	ListContracts(ctx context.Context, req *ethereum.ListContractsRequest) (*ethereum.ContractList, error)
+	GetContract(ctx context.Context, req *ethereum.GetContractRequest) (*ethereum.ContractInfo, error)
+	Call(ctx context.Context, req *ethereum.CallRequest) (*ethereum.CallResult, error)
+	Transact(ctx context.Context, req *ethereum.TransactRequest) (*ethereum.TransactionInfo, error)
+	EstimateGas(ctx context.Context, req *ethereum.EstimateGasRequest) (*ethereum.GasEstimate, error)
+	EncodeCall(ctx context.Context, req *ethereum.EncodeCallRequest) (*ethereum.EncodedCall, error)
+	DecodeOutput(ctx context.Context, req *ethereum.DecodeOutputRequest) (*ethereum.CallResult, error)
+	DecodeInput(ctx context.Context, req *ethereum.DecodeInputRequest) (*ethereum.DecodedInput, error)
+	DecodeLog(ctx context.Context, req *ethereum.DecodeLogRequest) (*ethereum.DecodedLog, error)
+	Mine(ctx context.Context, req *ethereum.MineRequest) (*ethereum.MineResult, error)
+	GetBalance(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Balance, error)
+	GetNonce(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Nonce, error)
+	GetCode(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Code, error)
+	GetStorageAt(ctx context.Context, req *ethereum.StorageRequest) (*ethereum.Storage, error)
+	GetTransaction(ctx context.Context, req *ethereum.TransactionRequest) (*ethereum.Transaction, error)
+	GetTransactionReceipt(ctx context.Context, req *ethereum.TransactionRequest) (*ethereum.Receipt, error)
+	GetBlock(ctx context.Context, req *ethereum.BlockRequest) (*ethereum.Block, error)
+	GetChainHead(ctx context.Context, req *ethereum.ChainHeadRequest) (*ethereum.Block, error)
+	SubscribeLogs(filter *ethereum.LogFilter, stream ethereum.Ethereum_SubscribeLogsServer) error
+	SubscribeNewHeads(req *ethereum.NewHeadsRequest, stream ethereum.Ethereum_SubscribeNewHeadsServer) error
+	SubscribePendingTransactions(req *ethereum.PendingTransactionsRequest, stream ethereum.Ethereum_SubscribePendingTransactionsServer) error
+
+	// Accounts service
+	NewAccount(ctx context.Context, req *ethereum.NewAccountRequest) (*ethereum.Account, error)
+	ListAccounts(ctx context.Context, req *ethereum.ListAccountsRequest) (*ethereum.AccountList, error)
+	ImportAccount(ctx context.Context, req *ethereum.ImportAccountRequest) (*ethereum.Account, error)
+	ExportAccount(ctx context.Context, req *ethereum.ExportAccountRequest) (*ethereum.Keyfile, error)
+	UnlockAccount(ctx context.Context, req *ethereum.UnlockAccountRequest) (*ethereum.Account, error)
+	LockAccount(ctx context.Context, req *ethereum.LockAccountRequest) (*ethereum.Account, error)
 }
 
 type controller struct{}
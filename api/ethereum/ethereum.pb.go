@@ -9,8 +9,67 @@ It is generated from these files:
 	api/ethereum/ethereum.proto
 
 It has these top-level messages:
+	TxOptions
 	CompiledContract
 	DeploymentInfo
+	CallRequest
+	Value
+	CallResult
+	TransactRequest
+	EstimateGasRequest
+	GasEstimate
+	Log
+	Receipt
+	TransactionInfo
+	MineRequest
+	MineResult
+	Account
+	NewAccountRequest
+	ListAccountsRequest
+	AccountList
+	ImportAccountRequest
+	ExportAccountRequest
+	Keyfile
+	UnlockAccountRequest
+	LockAccountRequest
+	StateRequest
+	StorageRequest
+	Balance
+	Nonce
+	Code
+	Storage
+	TransactionRequest
+	Transaction
+	BlockRequest
+	ChainHeadRequest
+	Block
+	Topics
+	LogFilter
+	NewHeadsRequest
+	PendingTransactionsRequest
+	SourceFile
+	CompileRequest
+	ContractArtifact
+	CompileResult
+	DeploySourceRequest
+	ContractInfo
+	ImportContractRequest
+	ListContractsRequest
+	ContractList
+	GetContractRequest
+	EncodeCallRequest
+	EncodedCall
+	DecodeOutputRequest
+	DecodeInputRequest
+	DecodedInput
+	DecodeLogRequest
+	DecodedLog
+	CreateTokenRequest
+	TokenInfo
+	Token
+	RevokeTokenRequest
+	ListTokensRequest
+	TokenList
 */
 package ethereum
 
@@ -35,15 +94,83 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Quantities are decimal or 0x-prefixed hex strings, left empty for defaults
+type TxOptions struct {
+	// Sender account, defaults to the controller's account
+	From string `protobuf:"bytes,1,opt,name=from" json:"from,omitempty"`
+	// Defaults to the estimated gas
+	GasLimit string `protobuf:"bytes,2,opt,name=gas_limit,json=gasLimit" json:"gas_limit,omitempty"`
+	// Defaults to the gas price suggested by the backend
+	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice" json:"gas_price,omitempty"`
+	// Wei sent along, defaults to zero
+	Value string `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
+	// Defaults to the pending nonce of the sender
+	Nonce string `protobuf:"bytes,5,opt,name=nonce" json:"nonce,omitempty"`
+}
+
+func (m *TxOptions) Reset()                    { *m = TxOptions{} }
+func (m *TxOptions) String() string            { return proto.CompactTextString(m) }
+func (*TxOptions) ProtoMessage()               {}
+func (*TxOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *TxOptions) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TxOptions) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *TxOptions) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TxOptions) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TxOptions) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 type CompiledContract struct {
-	Abi  string `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	// May be left empty when code holds solc combined JSON output
+	Abi string `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	// Hex encoded bytecode, with or without 0x prefix, or the output of
+	// solc --combined-json abi,bin
 	Code string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	// JSON encoded constructor arguments, one element per ABI input
+	Args []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	// Raw bytecode, as an alternative to code
+	Bytecode []byte `protobuf:"bytes,4,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+	// Contract to pick from combined JSON output holding several contracts,
+	// also the name the deployment is registered under
+	Name    string     `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	Options *TxOptions `protobuf:"bytes,6,opt,name=options" json:"options,omitempty"`
+	// Version and tags the deployment is registered with
+	Version string   `protobuf:"bytes,7,opt,name=version" json:"version,omitempty"`
+	Tags    []string `protobuf:"bytes,8,rep,name=tags" json:"tags,omitempty"`
 }
 
 func (m *CompiledContract) Reset()                    { *m = CompiledContract{} }
 func (m *CompiledContract) String() string            { return proto.CompactTextString(m) }
 func (*CompiledContract) ProtoMessage()               {}
-func (*CompiledContract) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*CompiledContract) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
 func (m *CompiledContract) GetAbi() string {
 	if m != nil {
@@ -59,15 +186,59 @@ func (m *CompiledContract) GetCode() string {
 	return ""
 }
 
+func (m *CompiledContract) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *CompiledContract) GetBytecode() []byte {
+	if m != nil {
+		return m.Bytecode
+	}
+	return nil
+}
+
+func (m *CompiledContract) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CompiledContract) GetOptions() *TxOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *CompiledContract) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CompiledContract) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type DeploymentInfo struct {
 	DeployedAddress string `protobuf:"bytes,1,opt,name=deployed_address,json=deployedAddress" json:"deployed_address,omitempty"`
 	TransactionId   string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	// Zero while the deployment is pending
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
 }
 
 func (m *DeploymentInfo) Reset()                    { *m = DeploymentInfo{} }
 func (m *DeploymentInfo) String() string            { return proto.CompactTextString(m) }
 func (*DeploymentInfo) ProtoMessage()               {}
-func (*DeploymentInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*DeploymentInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *DeploymentInfo) GetDeployedAddress() string {
 	if m != nil {
@@ -83,77 +254,3511 @@ func (m *DeploymentInfo) GetTransactionId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CompiledContract)(nil), "ethereum.CompiledContract")
-	proto.RegisterType((*DeploymentInfo)(nil), "ethereum.DeploymentInfo")
+func (m *DeploymentInfo) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type CallRequest struct {
+	// Contract address, or registered name optionally suffixed by @version
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Defaults to the registered ABI of the contract
+	Abi    string `protobuf:"bytes,2,opt,name=abi" json:"abi,omitempty"`
+	Method string `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
+	// JSON encoded method arguments, one element per ABI input
+	Args []string `protobuf:"bytes,4,rep,name=args" json:"args,omitempty"`
+	// Call against the pending state instead of the latest block
+	Pending bool `protobuf:"varint,5,opt,name=pending" json:"pending,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CallRequest) Reset()                    { *m = CallRequest{} }
+func (m *CallRequest) String() string            { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()               {}
+func (*CallRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
-// Client API for Ethereum service
+func (m *CallRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
-type EthereumClient interface {
-	Deploy(ctx context.Context, in *CompiledContract, opts ...grpc.CallOption) (*DeploymentInfo, error)
+func (m *CallRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
 }
 
-type ethereumClient struct {
-	cc *grpc.ClientConn
+func (m *CallRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
 
-func NewEthereumClient(cc *grpc.ClientConn) EthereumClient {
-	return &ethereumClient{cc}
+func (m *CallRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
 }
 
-func (c *ethereumClient) Deploy(ctx context.Context, in *CompiledContract, opts ...grpc.CallOption) (*DeploymentInfo, error) {
-	out := new(DeploymentInfo)
-	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Deploy", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CallRequest) GetPending() bool {
+	if m != nil {
+		return m.Pending
 	}
-	return out, nil
+	return false
 }
 
-// Server API for Ethereum service
+type Value struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	// JSON encoded value
+	Json string `protobuf:"bytes,3,opt,name=json" json:"json,omitempty"`
+}
 
-type EthereumServer interface {
-	Deploy(context.Context, *CompiledContract) (*DeploymentInfo, error)
+func (m *Value) Reset()                    { *m = Value{} }
+func (m *Value) String() string            { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()               {}
+func (*Value) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Value) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func RegisterEthereumServer(s *grpc.Server, srv EthereumServer) {
-	s.RegisterService(&_Ethereum_serviceDesc, srv)
+func (m *Value) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func _Ethereum_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompiledContract)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Value) GetJson() string {
+	if m != nil {
+		return m.Json
 	}
-	if interceptor == nil {
-		return srv.(EthereumServer).Deploy(ctx, in)
+	return ""
+}
+
+type CallResult struct {
+	Outputs []*Value `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *CallResult) Reset()                    { *m = CallResult{} }
+func (m *CallResult) String() string            { return proto.CompactTextString(m) }
+func (*CallResult) ProtoMessage()               {}
+func (*CallResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *CallResult) GetOutputs() []*Value {
+	if m != nil {
+		return m.Outputs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.Ethereum/Deploy",
+	return nil
+}
+
+type TransactRequest struct {
+	// Contract address, or registered name optionally suffixed by @version
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Defaults to the registered ABI of the contract
+	Abi    string `protobuf:"bytes,2,opt,name=abi" json:"abi,omitempty"`
+	Method string `protobuf:"bytes,3,opt,name=method" json:"method,omitempty"`
+	// JSON encoded method arguments, one element per ABI input
+	Args []string `protobuf:"bytes,4,rep,name=args" json:"args,omitempty"`
+	// Wait for the transaction to be mined and return its receipt, not
+	// supported in the manual commit mode of the simulated backend
+	Wait    bool       `protobuf:"varint,5,opt,name=wait" json:"wait,omitempty"`
+	Options *TxOptions `protobuf:"bytes,6,opt,name=options" json:"options,omitempty"`
+}
+
+func (m *TransactRequest) Reset()                    { *m = TransactRequest{} }
+func (m *TransactRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactRequest) ProtoMessage()               {}
+func (*TransactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *TransactRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthereumServer).Deploy(ctx, req.(*CompiledContract))
+	return ""
+}
+
+func (m *TransactRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Ethereum_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.Ethereum",
-	HandlerType: (*EthereumServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deploy",
-			Handler:    _Ethereum_Deploy_Handler,
+func (m *TransactRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *TransactRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *TransactRequest) GetWait() bool {
+	if m != nil {
+		return m.Wait
+	}
+	return false
+}
+
+func (m *TransactRequest) GetOptions() *TxOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type EstimateGasRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*EstimateGasRequest_Deploy
+	//	*EstimateGasRequest_Transact
+	Payload isEstimateGasRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (m *EstimateGasRequest) Reset()                    { *m = EstimateGasRequest{} }
+func (m *EstimateGasRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateGasRequest) ProtoMessage()               {}
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type isEstimateGasRequest_Payload interface{ isEstimateGasRequest_Payload() }
+
+type EstimateGasRequest_Deploy struct {
+	Deploy *CompiledContract `protobuf:"bytes,1,opt,name=deploy,oneof"`
+}
+type EstimateGasRequest_Transact struct {
+	Transact *TransactRequest `protobuf:"bytes,2,opt,name=transact,oneof"`
+}
+
+func (*EstimateGasRequest_Deploy) isEstimateGasRequest_Payload()   {}
+func (*EstimateGasRequest_Transact) isEstimateGasRequest_Payload() {}
+
+func (m *EstimateGasRequest) GetPayload() isEstimateGasRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *EstimateGasRequest) GetDeploy() *CompiledContract {
+	if x, ok := m.GetPayload().(*EstimateGasRequest_Deploy); ok {
+		return x.Deploy
+	}
+	return nil
+}
+
+func (m *EstimateGasRequest) GetTransact() *TransactRequest {
+	if x, ok := m.GetPayload().(*EstimateGasRequest_Transact); ok {
+		return x.Transact
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*EstimateGasRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _EstimateGasRequest_OneofMarshaler, _EstimateGasRequest_OneofUnmarshaler, _EstimateGasRequest_OneofSizer, []interface{}{
+		(*EstimateGasRequest_Deploy)(nil),
+		(*EstimateGasRequest_Transact)(nil),
+	}
+}
+
+func _EstimateGasRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*EstimateGasRequest)
+	// payload
+	switch x := m.Payload.(type) {
+	case *EstimateGasRequest_Deploy:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Deploy); err != nil {
+			return err
+		}
+	case *EstimateGasRequest_Transact:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transact); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("EstimateGasRequest.Payload has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _EstimateGasRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*EstimateGasRequest)
+	switch tag {
+	case 1: // payload.deploy
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CompiledContract)
+		err := b.DecodeMessage(msg)
+		m.Payload = &EstimateGasRequest_Deploy{msg}
+		return true, err
+	case 2: // payload.transact
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TransactRequest)
+		err := b.DecodeMessage(msg)
+		m.Payload = &EstimateGasRequest_Transact{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _EstimateGasRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*EstimateGasRequest)
+	// payload
+	switch x := m.Payload.(type) {
+	case *EstimateGasRequest_Deploy:
+		s := proto.Size(x.Deploy)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *EstimateGasRequest_Transact:
+		s := proto.Size(x.Transact)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type GasEstimate struct {
+	Gas uint64 `protobuf:"varint,1,opt,name=gas" json:"gas,omitempty"`
+	// Gas price and total cost in wei
+	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice" json:"gas_price,omitempty"`
+	Cost     string `protobuf:"bytes,3,opt,name=cost" json:"cost,omitempty"`
+}
+
+func (m *GasEstimate) Reset()                    { *m = GasEstimate{} }
+func (m *GasEstimate) String() string            { return proto.CompactTextString(m) }
+func (*GasEstimate) ProtoMessage()               {}
+func (*GasEstimate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *GasEstimate) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *GasEstimate) GetCost() string {
+	if m != nil {
+		return m.Cost
+	}
+	return ""
+}
+
+type Log struct {
+	Address       string   `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Topics        []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	Data          string   `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	BlockNumber   uint64   `protobuf:"varint,4,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	TransactionId string   `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	Index         uint32   `protobuf:"varint,6,opt,name=index" json:"index,omitempty"`
+	// Name and fields of the matching ABI event, if any
+	Event  string   `protobuf:"bytes,7,opt,name=event" json:"event,omitempty"`
+	Fields []*Value `protobuf:"bytes,8,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *Log) Reset()                    { *m = Log{} }
+func (m *Log) String() string            { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()               {}
+func (*Log) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Log) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Log) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *Log) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Log) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Log) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *Log) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Log) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *Log) GetFields() []*Value {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type Receipt struct {
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	// Either "success" or "failed"
+	Status            string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	GasUsed           uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed" json:"gas_used,omitempty"`
+	CumulativeGasUsed uint64 `protobuf:"varint,4,opt,name=cumulative_gas_used,json=cumulativeGasUsed" json:"cumulative_gas_used,omitempty"`
+	ContractAddress   string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress" json:"contract_address,omitempty"`
+	Logs              []*Log `protobuf:"bytes,6,rep,name=logs" json:"logs,omitempty"`
+	BlockNumber       uint64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	BlockHash         string `protobuf:"bytes,8,opt,name=block_hash,json=blockHash" json:"block_hash,omitempty"`
+}
+
+func (m *Receipt) Reset()                    { *m = Receipt{} }
+func (m *Receipt) String() string            { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()               {}
+func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *Receipt) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *Receipt) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetCumulativeGasUsed() uint64 {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Receipt) GetLogs() []*Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *Receipt) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Receipt) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+type TransactionInfo struct {
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	// Only set when the request asked to wait for the transaction
+	Receipt *Receipt `protobuf:"bytes,2,opt,name=receipt" json:"receipt,omitempty"`
+	// Zero while the transaction is pending
+	BlockNumber uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+}
+
+func (m *TransactionInfo) Reset()                    { *m = TransactionInfo{} }
+func (m *TransactionInfo) String() string            { return proto.CompactTextString(m) }
+func (*TransactionInfo) ProtoMessage()               {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *TransactionInfo) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *TransactionInfo) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+type MineRequest struct {
+	// Number of blocks to commit, defaults to one
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *MineRequest) Reset()                    { *m = MineRequest{} }
+func (m *MineRequest) String() string            { return proto.CompactTextString(m) }
+func (*MineRequest) ProtoMessage()               {}
+func (*MineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *MineRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+type MineResult struct {
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	BlockHash   string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash" json:"block_hash,omitempty"`
+}
+
+func (m *MineResult) Reset()                    { *m = MineResult{} }
+func (m *MineResult) String() string            { return proto.CompactTextString(m) }
+func (*MineResult) ProtoMessage()               {}
+func (*MineResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *MineResult) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *MineResult) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+type Account struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Wei balance at the latest block
+	Balance string `protobuf:"bytes,2,opt,name=balance" json:"balance,omitempty"`
+}
+
+func (m *Account) Reset()                    { *m = Account{} }
+func (m *Account) String() string            { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()               {}
+func (*Account) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *Account) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Account) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+type NewAccountRequest struct {
+	// Passphrase encrypting the new key
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase" json:"passphrase,omitempty"`
+}
+
+func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
+func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewAccountRequest) ProtoMessage()               {}
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *NewAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type ListAccountsRequest struct {
+}
+
+func (m *ListAccountsRequest) Reset()                    { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()               {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+type AccountList struct {
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+}
+
+func (m *AccountList) Reset()                    { *m = AccountList{} }
+func (m *AccountList) String() string            { return proto.CompactTextString(m) }
+func (*AccountList) ProtoMessage()               {}
+func (*AccountList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *AccountList) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type ImportAccountRequest struct {
+	// Types that are valid to be assigned to Key:
+	//	*ImportAccountRequest_PrivateKey
+	//	*ImportAccountRequest_Keyfile
+	Key isImportAccountRequest_Key `protobuf_oneof:"key"`
+	// Passphrase encrypting a private key, or decrypting a keyfile
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase" json:"passphrase,omitempty"`
+	// Passphrase re-encrypting a keyfile, defaults to passphrase
+	NewPassphrase string `protobuf:"bytes,4,opt,name=new_passphrase,json=newPassphrase" json:"new_passphrase,omitempty"`
+}
+
+func (m *ImportAccountRequest) Reset()                    { *m = ImportAccountRequest{} }
+func (m *ImportAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportAccountRequest) ProtoMessage()               {}
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+type isImportAccountRequest_Key interface{ isImportAccountRequest_Key() }
+
+type ImportAccountRequest_PrivateKey struct {
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,oneof"`
+}
+type ImportAccountRequest_Keyfile struct {
+	Keyfile string `protobuf:"bytes,2,opt,name=keyfile,oneof"`
+}
+
+func (*ImportAccountRequest_PrivateKey) isImportAccountRequest_Key() {}
+func (*ImportAccountRequest_Keyfile) isImportAccountRequest_Key()    {}
+
+func (m *ImportAccountRequest) GetKey() isImportAccountRequest_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ImportAccountRequest) GetPrivateKey() string {
+	if x, ok := m.GetKey().(*ImportAccountRequest_PrivateKey); ok {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetKeyfile() string {
+	if x, ok := m.GetKey().(*ImportAccountRequest_Keyfile); ok {
+		return x.Keyfile
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ImportAccountRequest) GetNewPassphrase() string {
+	if m != nil {
+		return m.NewPassphrase
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ImportAccountRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ImportAccountRequest_OneofMarshaler, _ImportAccountRequest_OneofUnmarshaler, _ImportAccountRequest_OneofSizer, []interface{}{
+		(*ImportAccountRequest_PrivateKey)(nil),
+		(*ImportAccountRequest_Keyfile)(nil),
+	}
+}
+
+func _ImportAccountRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ImportAccountRequest)
+	// key
+	switch x := m.Key.(type) {
+	case *ImportAccountRequest_PrivateKey:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.PrivateKey)
+	case *ImportAccountRequest_Keyfile:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Keyfile)
+	case nil:
+	default:
+		return fmt.Errorf("ImportAccountRequest.Key has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ImportAccountRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ImportAccountRequest)
+	switch tag {
+	case 1: // key.private_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Key = &ImportAccountRequest_PrivateKey{x}
+		return true, err
+	case 2: // key.keyfile
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Key = &ImportAccountRequest_Keyfile{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ImportAccountRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ImportAccountRequest)
+	// key
+	switch x := m.Key.(type) {
+	case *ImportAccountRequest_PrivateKey:
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.PrivateKey)))
+		n += len(x.PrivateKey)
+	case *ImportAccountRequest_Keyfile:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Keyfile)))
+		n += len(x.Keyfile)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ExportAccountRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase" json:"passphrase,omitempty"`
+	// Passphrase encrypting the exported keyfile, defaults to passphrase
+	NewPassphrase string `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase" json:"new_passphrase,omitempty"`
+}
+
+func (m *ExportAccountRequest) Reset()                    { *m = ExportAccountRequest{} }
+func (m *ExportAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportAccountRequest) ProtoMessage()               {}
+func (*ExportAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *ExportAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExportAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *ExportAccountRequest) GetNewPassphrase() string {
+	if m != nil {
+		return m.NewPassphrase
+	}
+	return ""
+}
+
+type Keyfile struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Encrypted JSON keyfile
+	Keyfile string `protobuf:"bytes,2,opt,name=keyfile" json:"keyfile,omitempty"`
+}
+
+func (m *Keyfile) Reset()                    { *m = Keyfile{} }
+func (m *Keyfile) String() string            { return proto.CompactTextString(m) }
+func (*Keyfile) ProtoMessage()               {}
+func (*Keyfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Keyfile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Keyfile) GetKeyfile() string {
+	if m != nil {
+		return m.Keyfile
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase" json:"passphrase,omitempty"`
+	// Seconds to keep the account unlocked, at most an hour and five minutes
+	// if zero
+	Duration uint32 `protobuf:"varint,3,opt,name=duration" json:"duration,omitempty"`
+}
+
+func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()               {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *UnlockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type LockAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
+func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockAccountRequest) ProtoMessage()               {}
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *LockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type StateRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Block number, "latest" or "pending", defaults to "latest"
+	Block string `protobuf:"bytes,2,opt,name=block" json:"block,omitempty"`
+}
+
+func (m *StateRequest) Reset()                    { *m = StateRequest{} }
+func (m *StateRequest) String() string            { return proto.CompactTextString(m) }
+func (*StateRequest) ProtoMessage()               {}
+func (*StateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *StateRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StateRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+type StorageRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Storage slot, a decimal or hex number
+	Key string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	// Block number, "latest" or "pending", defaults to "latest"
+	Block string `protobuf:"bytes,3,opt,name=block" json:"block,omitempty"`
+}
+
+func (m *StorageRequest) Reset()                    { *m = StorageRequest{} }
+func (m *StorageRequest) String() string            { return proto.CompactTextString(m) }
+func (*StorageRequest) ProtoMessage()               {}
+func (*StorageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *StorageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+type Balance struct {
+	// Wei balance
+	Balance string `protobuf:"bytes,1,opt,name=balance" json:"balance,omitempty"`
+}
+
+func (m *Balance) Reset()                    { *m = Balance{} }
+func (m *Balance) String() string            { return proto.CompactTextString(m) }
+func (*Balance) ProtoMessage()               {}
+func (*Balance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *Balance) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+type Nonce struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce" json:"nonce,omitempty"`
+}
+
+func (m *Nonce) Reset()                    { *m = Nonce{} }
+func (m *Nonce) String() string            { return proto.CompactTextString(m) }
+func (*Nonce) ProtoMessage()               {}
+func (*Nonce) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *Nonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type Code struct {
+	// Hex encoded runtime bytecode, empty for plain accounts
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *Code) Reset()                    { *m = Code{} }
+func (m *Code) String() string            { return proto.CompactTextString(m) }
+func (*Code) ProtoMessage()               {}
+func (*Code) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *Code) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type Storage struct {
+	// Hex encoded 32 byte storage value
+	Value string `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *Storage) Reset()                    { *m = Storage{} }
+func (m *Storage) String() string            { return proto.CompactTextString(m) }
+func (*Storage) ProtoMessage()               {}
+func (*Storage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *Storage) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TransactionRequest struct {
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	// ABI of the called contract, to decode the input data and logs
+	Abi string `protobuf:"bytes,2,opt,name=abi" json:"abi,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *TransactionRequest) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+type Transaction struct {
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	From          string `protobuf:"bytes,2,opt,name=from" json:"from,omitempty"`
+	// Empty for contract creations
+	To string `protobuf:"bytes,3,opt,name=to" json:"to,omitempty"`
+	// Wei sent along
+	Value    string `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
+	Nonce    uint64 `protobuf:"varint,5,opt,name=nonce" json:"nonce,omitempty"`
+	Gas      uint64 `protobuf:"varint,6,opt,name=gas" json:"gas,omitempty"`
+	GasPrice string `protobuf:"bytes,7,opt,name=gas_price,json=gasPrice" json:"gas_price,omitempty"`
+	// Hex encoded input data
+	Input string `protobuf:"bytes,8,opt,name=input" json:"input,omitempty"`
+	// Zero and empty while the transaction is pending
+	BlockNumber uint64 `protobuf:"varint,9,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	BlockHash   string `protobuf:"bytes,10,opt,name=block_hash,json=blockHash" json:"block_hash,omitempty"`
+	// Called method and its arguments, decoded when the ABI is known
+	Method string   `protobuf:"bytes,11,opt,name=method" json:"method,omitempty"`
+	Args   []*Value `protobuf:"bytes,12,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *Transaction) Reset()                    { *m = Transaction{} }
+func (m *Transaction) String() string            { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()               {}
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *Transaction) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *Transaction) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Transaction) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *Transaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *Transaction) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *Transaction) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *Transaction) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Transaction) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Transaction) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Transaction) GetArgs() []*Value {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type BlockRequest struct {
+	// Block number, hash or "latest", defaults to "latest"
+	Block string `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	// Include the full transactions rather than their ids only
+	FullTransactions bool `protobuf:"varint,2,opt,name=full_transactions,json=fullTransactions" json:"full_transactions,omitempty"`
+}
+
+func (m *BlockRequest) Reset()                    { *m = BlockRequest{} }
+func (m *BlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()               {}
+func (*BlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *BlockRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *BlockRequest) GetFullTransactions() bool {
+	if m != nil {
+		return m.FullTransactions
+	}
+	return false
+}
+
+type ChainHeadRequest struct {
+}
+
+func (m *ChainHeadRequest) Reset()                    { *m = ChainHeadRequest{} }
+func (m *ChainHeadRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChainHeadRequest) ProtoMessage()               {}
+func (*ChainHeadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type Block struct {
+	Number     uint64 `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
+	Hash       string `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	ParentHash string `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash" json:"parent_hash,omitempty"`
+	Miner      string `protobuf:"bytes,4,opt,name=miner" json:"miner,omitempty"`
+	// Unix time in seconds
+	Timestamp  uint64 `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	Difficulty string `protobuf:"bytes,6,opt,name=difficulty" json:"difficulty,omitempty"`
+	GasLimit   uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit" json:"gas_limit,omitempty"`
+	GasUsed    uint64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed" json:"gas_used,omitempty"`
+	// Hex encoded extra data
+	ExtraData string `protobuf:"bytes,9,opt,name=extra_data,json=extraData" json:"extra_data,omitempty"`
+	// Size in bytes
+	Size           uint64   `protobuf:"varint,10,opt,name=size" json:"size,omitempty"`
+	TransactionIds []string `protobuf:"bytes,11,rep,name=transaction_ids,json=transactionIds" json:"transaction_ids,omitempty"`
+	// Only set when full transactions were requested
+	Transactions []*Transaction `protobuf:"bytes,12,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *Block) Reset()                    { *m = Block{} }
+func (m *Block) String() string            { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()               {}
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *Block) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Block) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Block) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *Block) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *Block) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Block) GetDifficulty() string {
+	if m != nil {
+		return m.Difficulty
+	}
+	return ""
+}
+
+func (m *Block) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Block) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Block) GetExtraData() string {
+	if m != nil {
+		return m.ExtraData
+	}
+	return ""
+}
+
+func (m *Block) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Block) GetTransactionIds() []string {
+	if m != nil {
+		return m.TransactionIds
+	}
+	return nil
+}
+
+func (m *Block) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type Topics struct {
+	// Alternatives matching a topic position, empty matching any topic
+	Topics []string `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *Topics) Reset()                    { *m = Topics{} }
+func (m *Topics) String() string            { return proto.CompactTextString(m) }
+func (*Topics) ProtoMessage()               {}
+func (*Topics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *Topics) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type LogFilter struct {
+	// Contracts to watch, empty matching any contract
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	// Topic filters by position. When event is set they apply to the
+	// indexed arguments, starting at the second topic.
+	Topics []*Topics `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// Name of the ABI event to watch
+	Event string `protobuf:"bytes,3,opt,name=event" json:"event,omitempty"`
+	// ABI of the watched contracts, to resolve event and decode logs
+	Abi string `protobuf:"bytes,4,opt,name=abi" json:"abi,omitempty"`
+	// Block number to replay logs from, defaults to new logs only
+	FromBlock string `protobuf:"bytes,5,opt,name=from_block,json=fromBlock" json:"from_block,omitempty"`
+}
+
+func (m *LogFilter) Reset()                    { *m = LogFilter{} }
+func (m *LogFilter) String() string            { return proto.CompactTextString(m) }
+func (*LogFilter) ProtoMessage()               {}
+func (*LogFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *LogFilter) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LogFilter) GetTopics() []*Topics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *LogFilter) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *LogFilter) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *LogFilter) GetFromBlock() string {
+	if m != nil {
+		return m.FromBlock
+	}
+	return ""
+}
+
+type NewHeadsRequest struct {
+}
+
+func (m *NewHeadsRequest) Reset()                    { *m = NewHeadsRequest{} }
+func (m *NewHeadsRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewHeadsRequest) ProtoMessage()               {}
+func (*NewHeadsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+type PendingTransactionsRequest struct {
+}
+
+func (m *PendingTransactionsRequest) Reset()                    { *m = PendingTransactionsRequest{} }
+func (m *PendingTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransactionsRequest) ProtoMessage()               {}
+func (*PendingTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+type SourceFile struct {
+	// Unit name, also used to resolve imports
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content" json:"content,omitempty"`
+}
+
+func (m *SourceFile) Reset()                    { *m = SourceFile{} }
+func (m *SourceFile) String() string            { return proto.CompactTextString(m) }
+func (*SourceFile) ProtoMessage()               {}
+func (*SourceFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *SourceFile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SourceFile) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CompileRequest struct {
+	Sources  []*SourceFile `protobuf:"bytes,1,rep,name=sources" json:"sources,omitempty"`
+	Optimize bool          `protobuf:"varint,2,opt,name=optimize" json:"optimize,omitempty"`
+	// Optimizer runs, defaults to 200
+	OptimizeRuns uint32 `protobuf:"varint,3,opt,name=optimize_runs,json=optimizeRuns" json:"optimize_runs,omitempty"`
+	// Names of the contracts to return, all of them when empty
+	Contracts []string `protobuf:"bytes,4,rep,name=contracts" json:"contracts,omitempty"`
+}
+
+func (m *CompileRequest) Reset()                    { *m = CompileRequest{} }
+func (m *CompileRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompileRequest) ProtoMessage()               {}
+func (*CompileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *CompileRequest) GetSources() []*SourceFile {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompileRequest) GetOptimize() bool {
+	if m != nil {
+		return m.Optimize
+	}
+	return false
+}
+
+func (m *CompileRequest) GetOptimizeRuns() uint32 {
+	if m != nil {
+		return m.OptimizeRuns
+	}
+	return 0
+}
+
+func (m *CompileRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type ContractArtifact struct {
+	// Source unit defining the contract
+	Source string `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Abi    string `protobuf:"bytes,3,opt,name=abi" json:"abi,omitempty"`
+	// Hex encoded creation and runtime bytecode
+	Bytecode        string `protobuf:"bytes,4,opt,name=bytecode" json:"bytecode,omitempty"`
+	RuntimeBytecode string `protobuf:"bytes,5,opt,name=runtime_bytecode,json=runtimeBytecode" json:"runtime_bytecode,omitempty"`
+	// JSON encoded compiler metadata
+	Metadata string `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
+	// Warnings reported for the source unit defining the contract
+	Warnings []string `protobuf:"bytes,7,rep,name=warnings" json:"warnings,omitempty"`
+}
+
+func (m *ContractArtifact) Reset()                    { *m = ContractArtifact{} }
+func (m *ContractArtifact) String() string            { return proto.CompactTextString(m) }
+func (*ContractArtifact) ProtoMessage()               {}
+func (*ContractArtifact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *ContractArtifact) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetBytecode() string {
+	if m != nil {
+		return m.Bytecode
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetRuntimeBytecode() string {
+	if m != nil {
+		return m.RuntimeBytecode
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *ContractArtifact) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type CompileResult struct {
+	Contracts []*ContractArtifact `protobuf:"bytes,1,rep,name=contracts" json:"contracts,omitempty"`
+	// Warnings not tied to any source unit
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings" json:"warnings,omitempty"`
+}
+
+func (m *CompileResult) Reset()                    { *m = CompileResult{} }
+func (m *CompileResult) String() string            { return proto.CompactTextString(m) }
+func (*CompileResult) ProtoMessage()               {}
+func (*CompileResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *CompileResult) GetContracts() []*ContractArtifact {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *CompileResult) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type DeploySourceRequest struct {
+	Compile *CompileRequest `protobuf:"bytes,1,opt,name=compile" json:"compile,omitempty"`
+	// Contract to deploy, may be omitted if the sources define a single one
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// JSON encoded constructor arguments, one element per ABI input
+	Args    []string   `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	Options *TxOptions `protobuf:"bytes,4,opt,name=options" json:"options,omitempty"`
+	// Version and tags the deployment is registered with
+	Version string   `protobuf:"bytes,5,opt,name=version" json:"version,omitempty"`
+	Tags    []string `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *DeploySourceRequest) Reset()                    { *m = DeploySourceRequest{} }
+func (m *DeploySourceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeploySourceRequest) ProtoMessage()               {}
+func (*DeploySourceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *DeploySourceRequest) GetCompile() *CompileRequest {
+	if m != nil {
+		return m.Compile
+	}
+	return nil
+}
+
+func (m *DeploySourceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeploySourceRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *DeploySourceRequest) GetOptions() *TxOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *DeploySourceRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *DeploySourceRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ContractInfo struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	Abi     string `protobuf:"bytes,3,opt,name=abi" json:"abi,omitempty"`
+	// Keccak256 hash of the runtime bytecode, empty while the deployment is
+	// pending
+	BytecodeHash  string `protobuf:"bytes,4,opt,name=bytecode_hash,json=bytecodeHash" json:"bytecode_hash,omitempty"`
+	Address       string `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
+	TransactionId string `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
+	// Zero if unknown or still pending
+	BlockNumber uint64   `protobuf:"varint,7,opt,name=block_number,json=blockNumber" json:"block_number,omitempty"`
+	Deployer    string   `protobuf:"bytes,8,opt,name=deployer" json:"deployer,omitempty"`
+	Tags        []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (m *ContractInfo) Reset()                    { *m = ContractInfo{} }
+func (m *ContractInfo) String() string            { return proto.CompactTextString(m) }
+func (*ContractInfo) ProtoMessage()               {}
+func (*ContractInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ContractInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetBytecodeHash() string {
+	if m != nil {
+		return m.BytecodeHash
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *ContractInfo) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *ContractInfo) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ImportContractRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Defaults to the ABI of the artifact
+	Abi     string   `protobuf:"bytes,2,opt,name=abi" json:"abi,omitempty"`
+	Name    string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Version string   `protobuf:"bytes,4,opt,name=version" json:"version,omitempty"`
+	Tags    []string `protobuf:"bytes,5,rep,name=tags" json:"tags,omitempty"`
+	// Compiled contract whose runtime bytecode must match the code at address
+	Artifact *ContractArtifact `protobuf:"bytes,6,opt,name=artifact" json:"artifact,omitempty"`
+}
+
+func (m *ImportContractRequest) Reset()                    { *m = ImportContractRequest{} }
+func (m *ImportContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportContractRequest) ProtoMessage()               {}
+func (*ImportContractRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *ImportContractRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ImportContractRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *ImportContractRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportContractRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ImportContractRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ImportContractRequest) GetArtifact() *ContractArtifact {
+	if m != nil {
+		return m.Artifact
+	}
+	return nil
+}
+
+type ListContractsRequest struct {
+	// Only list contracts with this name
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Only list contracts with this tag
+	Tag string `protobuf:"bytes,2,opt,name=tag" json:"tag,omitempty"`
+}
+
+func (m *ListContractsRequest) Reset()                    { *m = ListContractsRequest{} }
+func (m *ListContractsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListContractsRequest) ProtoMessage()               {}
+func (*ListContractsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *ListContractsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListContractsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+type ContractList struct {
+	Contracts []*ContractInfo `protobuf:"bytes,1,rep,name=contracts" json:"contracts,omitempty"`
+}
+
+func (m *ContractList) Reset()                    { *m = ContractList{} }
+func (m *ContractList) String() string            { return proto.CompactTextString(m) }
+func (*ContractList) ProtoMessage()               {}
+func (*ContractList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *ContractList) GetContracts() []*ContractInfo {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type GetContractRequest struct {
+	// Contract address, or registered name optionally suffixed by @version
+	Contract string `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
+}
+
+func (m *GetContractRequest) Reset()                    { *m = GetContractRequest{} }
+func (m *GetContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractRequest) ProtoMessage()               {}
+func (*GetContractRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *GetContractRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type EncodeCallRequest struct {
+	Abi string `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	// Method name, empty to encode constructor arguments
+	Method string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	// JSON encoded arguments, one element per ABI input
+	Args []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *EncodeCallRequest) Reset()                    { *m = EncodeCallRequest{} }
+func (m *EncodeCallRequest) String() string            { return proto.CompactTextString(m) }
+func (*EncodeCallRequest) ProtoMessage()               {}
+func (*EncodeCallRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *EncodeCallRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *EncodeCallRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *EncodeCallRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type EncodedCall struct {
+	// 0x-prefixed ABI encoded data, including the method selector
+	Data string `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *EncodedCall) Reset()                    { *m = EncodedCall{} }
+func (m *EncodedCall) String() string            { return proto.CompactTextString(m) }
+func (*EncodedCall) ProtoMessage()               {}
+func (*EncodedCall) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *EncodedCall) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DecodeOutputRequest struct {
+	Abi    string `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+	// 0x-prefixed return data of the method
+	Data string `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *DecodeOutputRequest) Reset()                    { *m = DecodeOutputRequest{} }
+func (m *DecodeOutputRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodeOutputRequest) ProtoMessage()               {}
+func (*DecodeOutputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *DecodeOutputRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *DecodeOutputRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DecodeOutputRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DecodeInputRequest struct {
+	Abi string `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	// 0x-prefixed call data, including the method selector
+	Data string `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *DecodeInputRequest) Reset()                    { *m = DecodeInputRequest{} }
+func (m *DecodeInputRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodeInputRequest) ProtoMessage()               {}
+func (*DecodeInputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *DecodeInputRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *DecodeInputRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DecodedInput struct {
+	Method string   `protobuf:"bytes,1,opt,name=method" json:"method,omitempty"`
+	Args   []*Value `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *DecodedInput) Reset()                    { *m = DecodedInput{} }
+func (m *DecodedInput) String() string            { return proto.CompactTextString(m) }
+func (*DecodedInput) ProtoMessage()               {}
+func (*DecodedInput) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *DecodedInput) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DecodedInput) GetArgs() []*Value {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type DecodeLogRequest struct {
+	Abi    string   `protobuf:"bytes,1,opt,name=abi" json:"abi,omitempty"`
+	Topics []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	Data   string   `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *DecodeLogRequest) Reset()                    { *m = DecodeLogRequest{} }
+func (m *DecodeLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodeLogRequest) ProtoMessage()               {}
+func (*DecodeLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *DecodeLogRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *DecodeLogRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *DecodeLogRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DecodedLog struct {
+	Event  string   `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+	Fields []*Value `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *DecodedLog) Reset()                    { *m = DecodedLog{} }
+func (m *DecodedLog) String() string            { return proto.CompactTextString(m) }
+func (*DecodedLog) ProtoMessage()               {}
+func (*DecodedLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *DecodedLog) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *DecodedLog) GetFields() []*Value {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type CreateTokenRequest struct {
+	// Description of the token holder
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// read-only, transact, deploy or admin
+	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	// Accounts transactions may be sent from, any if empty
+	Accounts []string `protobuf:"bytes,3,rep,name=accounts" json:"accounts,omitempty"`
+	// Addresses or registered names of the contracts which may be invoked,
+	// any if empty. Names are pinned to the address they point to now.
+	Contracts []string `protobuf:"bytes,4,rep,name=contracts" json:"contracts,omitempty"`
+}
+
+func (m *CreateTokenRequest) Reset()                    { *m = CreateTokenRequest{} }
+func (m *CreateTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateTokenRequest) ProtoMessage()               {}
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *CreateTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateTokenRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *CreateTokenRequest) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *CreateTokenRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type TokenInfo struct {
+	Id        string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Role      string   `protobuf:"bytes,3,opt,name=role" json:"role,omitempty"`
+	Accounts  []string `protobuf:"bytes,4,rep,name=accounts" json:"accounts,omitempty"`
+	Contracts []string `protobuf:"bytes,5,rep,name=contracts" json:"contracts,omitempty"`
+	// Unix time of creation
+	Created int64 `protobuf:"varint,6,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *TokenInfo) Reset()                    { *m = TokenInfo{} }
+func (m *TokenInfo) String() string            { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()               {}
+func (*TokenInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *TokenInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+type Token struct {
+	Info *TokenInfo `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	// Bearer token, which is only ever returned here
+	Token string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *Token) Reset()                    { *m = Token{} }
+func (m *Token) String() string            { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()               {}
+func (*Token) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *Token) GetInfo() *TokenInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *Token) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RevokeTokenRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *RevokeTokenRequest) Reset()                    { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()               {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *RevokeTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListTokensRequest struct {
+}
+
+func (m *ListTokensRequest) Reset()                    { *m = ListTokensRequest{} }
+func (m *ListTokensRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTokensRequest) ProtoMessage()               {}
+func (*ListTokensRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+type TokenList struct {
+	Tokens []*TokenInfo `protobuf:"bytes,1,rep,name=tokens" json:"tokens,omitempty"`
+}
+
+func (m *TokenList) Reset()                    { *m = TokenList{} }
+func (m *TokenList) String() string            { return proto.CompactTextString(m) }
+func (*TokenList) ProtoMessage()               {}
+func (*TokenList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *TokenList) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxOptions)(nil), "ethereum.TxOptions")
+	proto.RegisterType((*CompiledContract)(nil), "ethereum.CompiledContract")
+	proto.RegisterType((*DeploymentInfo)(nil), "ethereum.DeploymentInfo")
+	proto.RegisterType((*CallRequest)(nil), "ethereum.CallRequest")
+	proto.RegisterType((*Value)(nil), "ethereum.Value")
+	proto.RegisterType((*CallResult)(nil), "ethereum.CallResult")
+	proto.RegisterType((*TransactRequest)(nil), "ethereum.TransactRequest")
+	proto.RegisterType((*EstimateGasRequest)(nil), "ethereum.EstimateGasRequest")
+	proto.RegisterType((*GasEstimate)(nil), "ethereum.GasEstimate")
+	proto.RegisterType((*Log)(nil), "ethereum.Log")
+	proto.RegisterType((*Receipt)(nil), "ethereum.Receipt")
+	proto.RegisterType((*TransactionInfo)(nil), "ethereum.TransactionInfo")
+	proto.RegisterType((*MineRequest)(nil), "ethereum.MineRequest")
+	proto.RegisterType((*MineResult)(nil), "ethereum.MineResult")
+	proto.RegisterType((*Account)(nil), "ethereum.Account")
+	proto.RegisterType((*NewAccountRequest)(nil), "ethereum.NewAccountRequest")
+	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.ListAccountsRequest")
+	proto.RegisterType((*AccountList)(nil), "ethereum.AccountList")
+	proto.RegisterType((*ImportAccountRequest)(nil), "ethereum.ImportAccountRequest")
+	proto.RegisterType((*ExportAccountRequest)(nil), "ethereum.ExportAccountRequest")
+	proto.RegisterType((*Keyfile)(nil), "ethereum.Keyfile")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "ethereum.UnlockAccountRequest")
+	proto.RegisterType((*LockAccountRequest)(nil), "ethereum.LockAccountRequest")
+	proto.RegisterType((*StateRequest)(nil), "ethereum.StateRequest")
+	proto.RegisterType((*StorageRequest)(nil), "ethereum.StorageRequest")
+	proto.RegisterType((*Balance)(nil), "ethereum.Balance")
+	proto.RegisterType((*Nonce)(nil), "ethereum.Nonce")
+	proto.RegisterType((*Code)(nil), "ethereum.Code")
+	proto.RegisterType((*Storage)(nil), "ethereum.Storage")
+	proto.RegisterType((*TransactionRequest)(nil), "ethereum.TransactionRequest")
+	proto.RegisterType((*Transaction)(nil), "ethereum.Transaction")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.BlockRequest")
+	proto.RegisterType((*ChainHeadRequest)(nil), "ethereum.ChainHeadRequest")
+	proto.RegisterType((*Block)(nil), "ethereum.Block")
+	proto.RegisterType((*Topics)(nil), "ethereum.Topics")
+	proto.RegisterType((*LogFilter)(nil), "ethereum.LogFilter")
+	proto.RegisterType((*NewHeadsRequest)(nil), "ethereum.NewHeadsRequest")
+	proto.RegisterType((*PendingTransactionsRequest)(nil), "ethereum.PendingTransactionsRequest")
+	proto.RegisterType((*SourceFile)(nil), "ethereum.SourceFile")
+	proto.RegisterType((*CompileRequest)(nil), "ethereum.CompileRequest")
+	proto.RegisterType((*ContractArtifact)(nil), "ethereum.ContractArtifact")
+	proto.RegisterType((*CompileResult)(nil), "ethereum.CompileResult")
+	proto.RegisterType((*DeploySourceRequest)(nil), "ethereum.DeploySourceRequest")
+	proto.RegisterType((*ContractInfo)(nil), "ethereum.ContractInfo")
+	proto.RegisterType((*ImportContractRequest)(nil), "ethereum.ImportContractRequest")
+	proto.RegisterType((*ListContractsRequest)(nil), "ethereum.ListContractsRequest")
+	proto.RegisterType((*ContractList)(nil), "ethereum.ContractList")
+	proto.RegisterType((*GetContractRequest)(nil), "ethereum.GetContractRequest")
+	proto.RegisterType((*EncodeCallRequest)(nil), "ethereum.EncodeCallRequest")
+	proto.RegisterType((*EncodedCall)(nil), "ethereum.EncodedCall")
+	proto.RegisterType((*DecodeOutputRequest)(nil), "ethereum.DecodeOutputRequest")
+	proto.RegisterType((*DecodeInputRequest)(nil), "ethereum.DecodeInputRequest")
+	proto.RegisterType((*DecodedInput)(nil), "ethereum.DecodedInput")
+	proto.RegisterType((*DecodeLogRequest)(nil), "ethereum.DecodeLogRequest")
+	proto.RegisterType((*DecodedLog)(nil), "ethereum.DecodedLog")
+	proto.RegisterType((*CreateTokenRequest)(nil), "ethereum.CreateTokenRequest")
+	proto.RegisterType((*TokenInfo)(nil), "ethereum.TokenInfo")
+	proto.RegisterType((*Token)(nil), "ethereum.Token")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "ethereum.RevokeTokenRequest")
+	proto.RegisterType((*ListTokensRequest)(nil), "ethereum.ListTokensRequest")
+	proto.RegisterType((*TokenList)(nil), "ethereum.TokenList")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Ethereum service
+
+type EthereumClient interface {
+	Deploy(ctx context.Context, in *CompiledContract, opts ...grpc.CallOption) (*DeploymentInfo, error)
+	Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (*CompileResult, error)
+	DeploySource(ctx context.Context, in *DeploySourceRequest, opts ...grpc.CallOption) (*DeploymentInfo, error)
+	RegisterContract(ctx context.Context, in *ContractInfo, opts ...grpc.CallOption) (*ContractInfo, error)
+	ImportContract(ctx context.Context, in *ImportContractRequest, opts ...grpc.CallOption) (*ContractInfo, error)
+	ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ContractList, error)
+	GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractInfo, error)
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResult, error)
+	Transact(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*TransactionInfo, error)
+	EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*GasEstimate, error)
+	EncodeCall(ctx context.Context, in *EncodeCallRequest, opts ...grpc.CallOption) (*EncodedCall, error)
+	DecodeOutput(ctx context.Context, in *DecodeOutputRequest, opts ...grpc.CallOption) (*CallResult, error)
+	DecodeInput(ctx context.Context, in *DecodeInputRequest, opts ...grpc.CallOption) (*DecodedInput, error)
+	DecodeLog(ctx context.Context, in *DecodeLogRequest, opts ...grpc.CallOption) (*DecodedLog, error)
+	Mine(ctx context.Context, in *MineRequest, opts ...grpc.CallOption) (*MineResult, error)
+	GetBalance(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Balance, error)
+	GetNonce(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Nonce, error)
+	GetCode(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Code, error)
+	GetStorageAt(ctx context.Context, in *StorageRequest, opts ...grpc.CallOption) (*Storage, error)
+	GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	GetTransactionReceipt(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*Receipt, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error)
+	GetChainHead(ctx context.Context, in *ChainHeadRequest, opts ...grpc.CallOption) (*Block, error)
+	SubscribeLogs(ctx context.Context, in *LogFilter, opts ...grpc.CallOption) (Ethereum_SubscribeLogsClient, error)
+	SubscribeNewHeads(ctx context.Context, in *NewHeadsRequest, opts ...grpc.CallOption) (Ethereum_SubscribeNewHeadsClient, error)
+	SubscribePendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (Ethereum_SubscribePendingTransactionsClient, error)
+}
+
+type ethereumClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEthereumClient(cc *grpc.ClientConn) EthereumClient {
+	return &ethereumClient{cc}
+}
+
+func (c *ethereumClient) Deploy(ctx context.Context, in *CompiledContract, opts ...grpc.CallOption) (*DeploymentInfo, error) {
+	out := new(DeploymentInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Deploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) Compile(ctx context.Context, in *CompileRequest, opts ...grpc.CallOption) (*CompileResult, error) {
+	out := new(CompileResult)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Compile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) DeploySource(ctx context.Context, in *DeploySourceRequest, opts ...grpc.CallOption) (*DeploymentInfo, error) {
+	out := new(DeploymentInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/DeploySource", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) RegisterContract(ctx context.Context, in *ContractInfo, opts ...grpc.CallOption) (*ContractInfo, error) {
+	out := new(ContractInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/RegisterContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) ImportContract(ctx context.Context, in *ImportContractRequest, opts ...grpc.CallOption) (*ContractInfo, error) {
+	out := new(ContractInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/ImportContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ContractList, error) {
+	out := new(ContractList)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/ListContracts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*ContractInfo, error) {
+	out := new(ContractInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResult, error) {
+	out := new(CallResult)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Call", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) Transact(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*TransactionInfo, error) {
+	out := new(TransactionInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Transact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) EstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*GasEstimate, error) {
+	out := new(GasEstimate)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) EncodeCall(ctx context.Context, in *EncodeCallRequest, opts ...grpc.CallOption) (*EncodedCall, error) {
+	out := new(EncodedCall)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/EncodeCall", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) DecodeOutput(ctx context.Context, in *DecodeOutputRequest, opts ...grpc.CallOption) (*CallResult, error) {
+	out := new(CallResult)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/DecodeOutput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) DecodeInput(ctx context.Context, in *DecodeInputRequest, opts ...grpc.CallOption) (*DecodedInput, error) {
+	out := new(DecodedInput)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/DecodeInput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) DecodeLog(ctx context.Context, in *DecodeLogRequest, opts ...grpc.CallOption) (*DecodedLog, error) {
+	out := new(DecodedLog)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/DecodeLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) Mine(ctx context.Context, in *MineRequest, opts ...grpc.CallOption) (*MineResult, error) {
+	out := new(MineResult)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/Mine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetBalance(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetNonce(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Nonce, error) {
+	out := new(Nonce)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetNonce", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetCode(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*Code, error) {
+	out := new(Code)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetCode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetStorageAt(ctx context.Context, in *StorageRequest, opts ...grpc.CallOption) (*Storage, error) {
+	out := new(Storage)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetStorageAt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetTransactionReceipt(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	out := new(Receipt)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetTransactionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) GetChainHead(ctx context.Context, in *ChainHeadRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := grpc.Invoke(ctx, "/ethereum.Ethereum/GetChainHead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethereumClient) SubscribeLogs(ctx context.Context, in *LogFilter, opts ...grpc.CallOption) (Ethereum_SubscribeLogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Ethereum_serviceDesc.Streams[0], c.cc, "/ethereum.Ethereum/SubscribeLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ethereumSubscribeLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ethereum_SubscribeLogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type ethereumSubscribeLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ethereumSubscribeLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ethereumClient) SubscribeNewHeads(ctx context.Context, in *NewHeadsRequest, opts ...grpc.CallOption) (Ethereum_SubscribeNewHeadsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Ethereum_serviceDesc.Streams[1], c.cc, "/ethereum.Ethereum/SubscribeNewHeads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ethereumSubscribeNewHeadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ethereum_SubscribeNewHeadsClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type ethereumSubscribeNewHeadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ethereumSubscribeNewHeadsClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ethereumClient) SubscribePendingTransactions(ctx context.Context, in *PendingTransactionsRequest, opts ...grpc.CallOption) (Ethereum_SubscribePendingTransactionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Ethereum_serviceDesc.Streams[2], c.cc, "/ethereum.Ethereum/SubscribePendingTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ethereumSubscribePendingTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ethereum_SubscribePendingTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type ethereumSubscribePendingTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ethereumSubscribePendingTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Ethereum service
+
+type EthereumServer interface {
+	Deploy(context.Context, *CompiledContract) (*DeploymentInfo, error)
+	Compile(context.Context, *CompileRequest) (*CompileResult, error)
+	DeploySource(context.Context, *DeploySourceRequest) (*DeploymentInfo, error)
+	RegisterContract(context.Context, *ContractInfo) (*ContractInfo, error)
+	ImportContract(context.Context, *ImportContractRequest) (*ContractInfo, error)
+	ListContracts(context.Context, *ListContractsRequest) (*ContractList, error)
+	GetContract(context.Context, *GetContractRequest) (*ContractInfo, error)
+	Call(context.Context, *CallRequest) (*CallResult, error)
+	Transact(context.Context, *TransactRequest) (*TransactionInfo, error)
+	EstimateGas(context.Context, *EstimateGasRequest) (*GasEstimate, error)
+	EncodeCall(context.Context, *EncodeCallRequest) (*EncodedCall, error)
+	DecodeOutput(context.Context, *DecodeOutputRequest) (*CallResult, error)
+	DecodeInput(context.Context, *DecodeInputRequest) (*DecodedInput, error)
+	DecodeLog(context.Context, *DecodeLogRequest) (*DecodedLog, error)
+	Mine(context.Context, *MineRequest) (*MineResult, error)
+	GetBalance(context.Context, *StateRequest) (*Balance, error)
+	GetNonce(context.Context, *StateRequest) (*Nonce, error)
+	GetCode(context.Context, *StateRequest) (*Code, error)
+	GetStorageAt(context.Context, *StorageRequest) (*Storage, error)
+	GetTransaction(context.Context, *TransactionRequest) (*Transaction, error)
+	GetTransactionReceipt(context.Context, *TransactionRequest) (*Receipt, error)
+	GetBlock(context.Context, *BlockRequest) (*Block, error)
+	GetChainHead(context.Context, *ChainHeadRequest) (*Block, error)
+	SubscribeLogs(*LogFilter, Ethereum_SubscribeLogsServer) error
+	SubscribeNewHeads(*NewHeadsRequest, Ethereum_SubscribeNewHeadsServer) error
+	SubscribePendingTransactions(*PendingTransactionsRequest, Ethereum_SubscribePendingTransactionsServer) error
+}
+
+func RegisterEthereumServer(s *grpc.Server, srv EthereumServer) {
+	s.RegisterService(&_Ethereum_serviceDesc, srv)
+}
+
+func _Ethereum_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompiledContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).Deploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/Deploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).Deploy(ctx, req.(*CompiledContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_Compile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).Compile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/Compile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).Compile(ctx, req.(*CompileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_DeploySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).DeploySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/DeploySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).DeploySource(ctx, req.(*DeploySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_RegisterContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).RegisterContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/RegisterContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).RegisterContract(ctx, req.(*ContractInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_ImportContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).ImportContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/ImportContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).ImportContract(ctx, req.(*ImportContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_ListContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).ListContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/ListContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).ListContracts(ctx, req.(*ListContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetContract(ctx, req.(*GetContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_Transact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).Transact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/Transact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).Transact(ctx, req.(*TransactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).EstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_EncodeCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).EncodeCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/EncodeCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).EncodeCall(ctx, req.(*EncodeCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_DecodeOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).DecodeOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/DecodeOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).DecodeOutput(ctx, req.(*DecodeOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_DecodeInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).DecodeInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/DecodeInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).DecodeInput(ctx, req.(*DecodeInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_DecodeLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).DecodeLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/DecodeLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).DecodeLog(ctx, req.(*DecodeLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_Mine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).Mine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/Mine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).Mine(ctx, req.(*MineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetBalance(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetNonce(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetCode(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetStorageAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetStorageAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetStorageAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetStorageAt(ctx, req.(*StorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetTransactionReceipt(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumServer).GetChainHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Ethereum/GetChainHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumServer).GetChainHead(ctx, req.(*ChainHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ethereum_SubscribeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EthereumServer).SubscribeLogs(m, &ethereumSubscribeLogsServer{stream})
+}
+
+type Ethereum_SubscribeLogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type ethereumSubscribeLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ethereumSubscribeLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ethereum_SubscribeNewHeads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NewHeadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EthereumServer).SubscribeNewHeads(m, &ethereumSubscribeNewHeadsServer{stream})
+}
+
+type Ethereum_SubscribeNewHeadsServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type ethereumSubscribeNewHeadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ethereumSubscribeNewHeadsServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ethereum_SubscribePendingTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PendingTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EthereumServer).SubscribePendingTransactions(m, &ethereumSubscribePendingTransactionsServer{stream})
+}
+
+type Ethereum_SubscribePendingTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type ethereumSubscribePendingTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ethereumSubscribePendingTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Ethereum_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.Ethereum",
+	HandlerType: (*EthereumServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deploy",
+			Handler:    _Ethereum_Deploy_Handler,
+		},
+		{
+			MethodName: "Compile",
+			Handler:    _Ethereum_Compile_Handler,
+		},
+		{
+			MethodName: "DeploySource",
+			Handler:    _Ethereum_DeploySource_Handler,
+		},
+		{
+			MethodName: "RegisterContract",
+			Handler:    _Ethereum_RegisterContract_Handler,
+		},
+		{
+			MethodName: "ImportContract",
+			Handler:    _Ethereum_ImportContract_Handler,
+		},
+		{
+			MethodName: "ListContracts",
+			Handler:    _Ethereum_ListContracts_Handler,
+		},
+		{
+			MethodName: "GetContract",
+			Handler:    _Ethereum_GetContract_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _Ethereum_Call_Handler,
+		},
+		{
+			MethodName: "Transact",
+			Handler:    _Ethereum_Transact_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Ethereum_EstimateGas_Handler,
+		},
+		{
+			MethodName: "EncodeCall",
+			Handler:    _Ethereum_EncodeCall_Handler,
+		},
+		{
+			MethodName: "DecodeOutput",
+			Handler:    _Ethereum_DecodeOutput_Handler,
+		},
+		{
+			MethodName: "DecodeInput",
+			Handler:    _Ethereum_DecodeInput_Handler,
+		},
+		{
+			MethodName: "DecodeLog",
+			Handler:    _Ethereum_DecodeLog_Handler,
+		},
+		{
+			MethodName: "Mine",
+			Handler:    _Ethereum_Mine_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Ethereum_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetNonce",
+			Handler:    _Ethereum_GetNonce_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _Ethereum_GetCode_Handler,
+		},
+		{
+			MethodName: "GetStorageAt",
+			Handler:    _Ethereum_GetStorageAt_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Ethereum_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _Ethereum_GetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Ethereum_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetChainHead",
+			Handler:    _Ethereum_GetChainHead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLogs",
+			Handler:       _Ethereum_SubscribeLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNewHeads",
+			Handler:       _Ethereum_SubscribeNewHeads_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePendingTransactions",
+			Handler:       _Ethereum_SubscribePendingTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/ethereum/ethereum.proto",
+}
+
+// Client API for Accounts service
+
+type AccountsClient interface {
+	NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*AccountList, error)
+	ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (*Keyfile, error)
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*Account, error)
+}
+
+type accountsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
+	return &accountsClient{cc}
+}
+
+func (c *accountsClient) NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/NewAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*AccountList, error) {
+	out := new(AccountList)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/ListAccounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ImportAccount(ctx context.Context, in *ImportAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/ImportAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (*Keyfile, error) {
+	out := new(Keyfile)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/ExportAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/UnlockAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/ethereum.Accounts/LockAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Accounts service
+
+type AccountsServer interface {
+	NewAccount(context.Context, *NewAccountRequest) (*Account, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*AccountList, error)
+	ImportAccount(context.Context, *ImportAccountRequest) (*Account, error)
+	ExportAccount(context.Context, *ExportAccountRequest) (*Keyfile, error)
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*Account, error)
+	LockAccount(context.Context, *LockAccountRequest) (*Account, error)
+}
+
+func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
+	s.RegisterService(&_Accounts_serviceDesc, srv)
+}
+
+func _Accounts_NewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).NewAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/NewAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).NewAccount(ctx, req.(*NewAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ImportAccount(ctx, req.(*ImportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/ExportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ExportAccount(ctx, req.(*ExportAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/UnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).LockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Accounts/LockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).LockAccount(ctx, req.(*LockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Accounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.Accounts",
+	HandlerType: (*AccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewAccount",
+			Handler:    _Accounts_NewAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Accounts_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _Accounts_ImportAccount_Handler,
+		},
+		{
+			MethodName: "ExportAccount",
+			Handler:    _Accounts_ExportAccount_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _Accounts_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "LockAccount",
+			Handler:    _Accounts_LockAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/ethereum/ethereum.proto",
+}
+
+// Client API for Tokens service
+
+type TokensClient interface {
+	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*Token, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*TokenInfo, error)
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*TokenList, error)
+}
+
+type tokensClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTokensClient(cc *grpc.ClientConn) TokensClient {
+	return &tokensClient{cc}
+}
+
+func (c *tokensClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := grpc.Invoke(ctx, "/ethereum.Tokens/CreateToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokensClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*TokenInfo, error) {
+	out := new(TokenInfo)
+	err := grpc.Invoke(ctx, "/ethereum.Tokens/RevokeToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokensClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*TokenList, error) {
+	out := new(TokenList)
+	err := grpc.Invoke(ctx, "/ethereum.Tokens/ListTokens", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Tokens service
+
+type TokensServer interface {
+	CreateToken(context.Context, *CreateTokenRequest) (*Token, error)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*TokenInfo, error)
+	ListTokens(context.Context, *ListTokensRequest) (*TokenList, error)
+}
+
+func RegisterTokensServer(s *grpc.Server, srv TokensServer) {
+	s.RegisterService(&_Tokens_serviceDesc, srv)
+}
+
+func _Tokens_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokensServer).CreateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Tokens/CreateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokensServer).CreateToken(ctx, req.(*CreateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tokens_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokensServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Tokens/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokensServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tokens_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokensServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.Tokens/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokensServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tokens_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.Tokens",
+	HandlerType: (*TokensServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateToken",
+			Handler:    _Tokens_CreateToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _Tokens_RevokeToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _Tokens_ListTokens_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -163,21 +3768,201 @@ var _Ethereum_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api/ethereum/ethereum.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0xc8, 0xd4,
-	0x4f, 0x2d, 0xc9, 0x48, 0x2d, 0x4a, 0x2d, 0xcd, 0x85, 0x33, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0x38, 0x60, 0x7c, 0x29, 0x99, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0x7d, 0x90, 0xea, 0xc4,
-	0xbc, 0xbc, 0xfc, 0x92, 0xc4, 0x92, 0xcc, 0xfc, 0xbc, 0x62, 0x88, 0x3a, 0x25, 0x0b, 0x2e, 0x01,
-	0xe7, 0xfc, 0xdc, 0x82, 0xcc, 0x9c, 0xd4, 0x14, 0xe7, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x12,
-	0x21, 0x01, 0x2e, 0xe6, 0xc4, 0xa4, 0x4c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x10, 0x53,
-	0x48, 0x88, 0x8b, 0x25, 0x39, 0x3f, 0x25, 0x55, 0x82, 0x09, 0x2c, 0x04, 0x66, 0x2b, 0x25, 0x71,
-	0xf1, 0xb9, 0xa4, 0x16, 0xe4, 0xe4, 0x57, 0xe6, 0xa6, 0xe6, 0x95, 0x78, 0xe6, 0xa5, 0xe5, 0x0b,
-	0x69, 0x72, 0x09, 0xa4, 0x80, 0x45, 0x52, 0x53, 0xe2, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b,
-	0xa1, 0x86, 0xf0, 0xc3, 0xc4, 0x1d, 0x21, 0xc2, 0x42, 0xaa, 0x5c, 0x7c, 0x40, 0xbb, 0xf2, 0x8a,
-	0x81, 0xd6, 0x01, 0x1d, 0x13, 0x9f, 0x99, 0x02, 0x35, 0x9a, 0x17, 0x49, 0xd4, 0x33, 0xc5, 0x28,
-	0x8b, 0x8b, 0xc3, 0x15, 0xea, 0x0f, 0xa1, 0x38, 0x2e, 0x36, 0x88, 0x7d, 0x42, 0x52, 0x7a, 0x70,
-	0xcf, 0xa2, 0xbb, 0x5d, 0x4a, 0x02, 0x21, 0x87, 0xea, 0x3a, 0x25, 0xb9, 0xa6, 0xcb, 0x4f, 0x26,
-	0x33, 0x49, 0x28, 0x09, 0xeb, 0x97, 0x19, 0xea, 0x27, 0x43, 0xd5, 0xeb, 0x43, 0x1c, 0x66, 0xc5,
-	0xa8, 0xe5, 0xc4, 0x15, 0x05, 0x0f, 0xb3, 0x24, 0x36, 0x70, 0xe0, 0x18, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0x6a, 0xbc, 0x40, 0x63, 0x01, 0x00, 0x00,
+	// 3127 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x3a, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x5e, 0x3e, 0x44, 0xf2, 0x23, 0x29, 0x4b, 0x23, 0xda, 0xa6, 0xd7, 0x52, 0x6c, 0x8f, 0xe3,
+	0x9f, 0x5f, 0x88, 0x99, 0x9f, 0x12, 0x34, 0xa9, 0x91, 0x16, 0xb0, 0x15, 0x47, 0x36, 0xac, 0x28,
+	0xce, 0xda, 0x29, 0xd2, 0xa6, 0x89, 0xb0, 0xe2, 0x8e, 0xa8, 0xad, 0xc8, 0x5d, 0x76, 0x77, 0xa8,
+	0x47, 0x0c, 0xa3, 0x40, 0x80, 0xa2, 0xed, 0xa5, 0x97, 0x1e, 0x0a, 0x14, 0x28, 0x7a, 0xc8, 0xb5,
+	0xff, 0x42, 0xef, 0x3d, 0xb5, 0x87, 0x9e, 0x7a, 0xea, 0xa5, 0xa7, 0xfe, 0x03, 0xbd, 0x16, 0xdf,
+	0x3c, 0x76, 0x67, 0xb9, 0x4b, 0x5a, 0x09, 0xda, 0x93, 0x76, 0xbe, 0x19, 0x7e, 0xef, 0xd7, 0x7c,
+	0x23, 0xb8, 0xe4, 0x8e, 0xfd, 0x1e, 0xe3, 0xfb, 0x2c, 0x62, 0x93, 0x51, 0xf2, 0x71, 0x77, 0x1c,
+	0x85, 0x3c, 0x24, 0x75, 0xbd, 0xb6, 0x57, 0x07, 0x61, 0x38, 0x18, 0xb2, 0x1e, 0x9e, 0x76, 0x83,
+	0x20, 0xe4, 0x2e, 0xf7, 0xc3, 0x20, 0x96, 0xe7, 0xe8, 0xcf, 0x2d, 0x68, 0x3c, 0x3f, 0xfe, 0x68,
+	0x2c, 0x60, 0x84, 0x40, 0x65, 0x2f, 0x0a, 0x47, 0x5d, 0xeb, 0x8a, 0x75, 0xb3, 0xe1, 0x88, 0x6f,
+	0x72, 0x09, 0x1a, 0x03, 0x37, 0xde, 0x19, 0xfa, 0x23, 0x9f, 0x77, 0x4b, 0x62, 0xa3, 0x3e, 0x70,
+	0xe3, 0x2d, 0x5c, 0xeb, 0xcd, 0x71, 0xe4, 0xf7, 0x59, 0xb7, 0x9c, 0x6c, 0x3e, 0xc5, 0x35, 0xe9,
+	0x40, 0xf5, 0xd0, 0x1d, 0x4e, 0x58, 0xb7, 0x22, 0x36, 0xe4, 0x02, 0xa1, 0x41, 0x18, 0xf4, 0x59,
+	0xb7, 0x2a, 0xa1, 0x62, 0x41, 0xff, 0x6e, 0xc1, 0xd2, 0x46, 0x38, 0x1a, 0xfb, 0x43, 0xe6, 0x6d,
+	0x84, 0x01, 0x8f, 0xdc, 0x3e, 0x27, 0x4b, 0x50, 0x76, 0x77, 0x7d, 0xc5, 0x0d, 0x7e, 0x22, 0x83,
+	0xfd, 0xd0, 0x63, 0x8a, 0x0f, 0xf1, 0x8d, 0x30, 0x37, 0x1a, 0xc4, 0xdd, 0xf2, 0x95, 0x32, 0xc2,
+	0xf0, 0x9b, 0xd8, 0x50, 0xdf, 0x3d, 0xe1, 0x4c, 0x9c, 0x45, 0xea, 0x2d, 0x27, 0x59, 0xe3, 0xf9,
+	0xc0, 0x1d, 0x69, 0xfa, 0xe2, 0x9b, 0xbc, 0x01, 0xb5, 0x50, 0xea, 0xa0, 0xbb, 0x70, 0xc5, 0xba,
+	0xd9, 0x5c, 0x5f, 0xb9, 0x9b, 0x28, 0x34, 0x51, 0x8f, 0xa3, 0xcf, 0x90, 0x2e, 0xd4, 0x0e, 0x59,
+	0x14, 0xfb, 0x61, 0xd0, 0xad, 0x09, 0x2c, 0x7a, 0x89, 0xc8, 0xb9, 0x3b, 0x88, 0xbb, 0x75, 0xc9,
+	0x0c, 0x7e, 0xa3, 0x8e, 0x17, 0xdf, 0x67, 0xe3, 0x61, 0x78, 0x32, 0x62, 0x01, 0x7f, 0x1c, 0xec,
+	0x85, 0xe4, 0x16, 0x2c, 0x79, 0x02, 0xc2, 0xbc, 0x1d, 0xd7, 0xf3, 0x22, 0x16, 0xc7, 0x4a, 0xcc,
+	0xb3, 0x1a, 0x7e, 0x5f, 0x82, 0xc9, 0x75, 0x58, 0xe4, 0x91, 0x1b, 0xc4, 0x6e, 0x1f, 0x69, 0xef,
+	0xf8, 0x9e, 0x12, 0xbe, 0x6d, 0x40, 0x1f, 0x7b, 0xe4, 0x2a, 0xb4, 0x76, 0x87, 0x61, 0xff, 0x60,
+	0x27, 0x98, 0x8c, 0x76, 0x59, 0x24, 0x8c, 0x51, 0x71, 0x9a, 0x02, 0xb6, 0x2d, 0x40, 0xf4, 0x67,
+	0xd0, 0xdc, 0x70, 0x87, 0x43, 0x87, 0xfd, 0x74, 0xc2, 0x62, 0x8e, 0x42, 0x64, 0x49, 0xeb, 0xa5,
+	0xd6, 0x7b, 0x29, 0xd5, 0xfb, 0x79, 0x58, 0x18, 0x31, 0xbe, 0x1f, 0x7a, 0xca, 0xc8, 0x6a, 0x95,
+	0xe8, 0xbe, 0x62, 0xe8, 0xbe, 0x0b, 0xb5, 0x31, 0x0b, 0x3c, 0x3f, 0x18, 0x08, 0x15, 0xd7, 0x1d,
+	0xbd, 0xa4, 0x1b, 0x50, 0xfd, 0x81, 0xf0, 0x01, 0x6d, 0x02, 0xcb, 0x30, 0x01, 0x6a, 0xee, 0x64,
+	0x9c, 0x98, 0x16, 0xbf, 0x11, 0xf6, 0x93, 0x38, 0x0c, 0x14, 0x51, 0xf1, 0x4d, 0xdf, 0x01, 0x90,
+	0x52, 0xc4, 0x93, 0x21, 0x27, 0xb7, 0xa0, 0x16, 0x4e, 0xf8, 0x78, 0xc2, 0x51, 0x88, 0xf2, 0xcd,
+	0xe6, 0xfa, 0xd9, 0xd4, 0x70, 0x82, 0x96, 0xa3, 0xf7, 0xe9, 0x1f, 0x2d, 0x38, 0xfb, 0x5c, 0xe9,
+	0xec, 0x7f, 0xad, 0x03, 0x02, 0x95, 0x23, 0xd7, 0xe7, 0x4a, 0x01, 0xe2, 0xfb, 0x1b, 0xfa, 0x18,
+	0xfd, 0xb5, 0x05, 0xe4, 0x61, 0xcc, 0xfd, 0x91, 0xcb, 0xd9, 0xa6, 0x1b, 0x6b, 0x8e, 0xdf, 0x86,
+	0x05, 0xe9, 0x21, 0x82, 0xe1, 0xe6, 0xba, 0x9d, 0x22, 0x99, 0x8e, 0x9f, 0x47, 0x67, 0x1c, 0x75,
+	0x96, 0xbc, 0x03, 0x75, 0xed, 0x2e, 0x42, 0xa4, 0xe6, 0xfa, 0x45, 0x83, 0x78, 0x56, 0x29, 0x8f,
+	0xce, 0x38, 0xc9, 0xe1, 0x07, 0x0d, 0xa8, 0x8d, 0xdd, 0x93, 0x61, 0xe8, 0x7a, 0xf4, 0x29, 0x34,
+	0x37, 0xdd, 0x58, 0xb3, 0x84, 0x0a, 0x1a, 0xb8, 0x52, 0x6d, 0x15, 0x07, 0x3f, 0xb3, 0xc9, 0xa0,
+	0x34, 0x95, 0x0c, 0x44, 0xe4, 0xc6, 0x5c, 0x9b, 0x12, 0xbf, 0xe9, 0xbf, 0x2c, 0x28, 0x6f, 0x85,
+	0x83, 0x39, 0x56, 0x38, 0x0f, 0x0b, 0x3c, 0x1c, 0xfb, 0xfd, 0xb8, 0x5b, 0x12, 0xda, 0x55, 0x2b,
+	0xc4, 0xe6, 0xb9, 0xdc, 0xd5, 0xd8, 0xf0, 0x3b, 0x17, 0x01, 0x95, 0x5c, 0x04, 0x14, 0xc4, 0x52,
+	0xb5, 0x28, 0x96, 0x3a, 0x50, 0xf5, 0x03, 0x8f, 0x1d, 0x0b, 0x3b, 0xb5, 0x1d, 0xb9, 0x40, 0x28,
+	0x3b, 0x64, 0x01, 0x57, 0x21, 0x2f, 0x17, 0xe4, 0x06, 0x2c, 0xec, 0xf9, 0x6c, 0xe8, 0xc9, 0x90,
+	0x2f, 0xf0, 0x3f, 0xb5, 0x4d, 0xbf, 0x2e, 0x41, 0xcd, 0x61, 0x7d, 0xe6, 0x8f, 0x79, 0x01, 0x1f,
+	0x56, 0x11, 0x1f, 0xe7, 0x61, 0x21, 0xe6, 0x2e, 0x9f, 0xc4, 0x4a, 0x9b, 0x6a, 0x45, 0x2e, 0x02,
+	0xea, 0x75, 0x67, 0x12, 0x33, 0x4f, 0xc5, 0x79, 0x6d, 0xe0, 0xc6, 0x9f, 0xc4, 0xcc, 0x23, 0x77,
+	0x61, 0xa5, 0x3f, 0x19, 0x4d, 0x86, 0x2e, 0xf7, 0x0f, 0xd9, 0x4e, 0x72, 0x4a, 0xea, 0x62, 0x39,
+	0xdd, 0xda, 0x54, 0xe7, 0x6f, 0xc1, 0x52, 0x5f, 0xb9, 0x4b, 0x92, 0x88, 0xa4, 0x4e, 0xce, 0x6a,
+	0xb8, 0x4e, 0x44, 0x57, 0xa1, 0x32, 0x0c, 0x07, 0xe8, 0xbc, 0x28, 0x67, 0x3b, 0x95, 0x73, 0x2b,
+	0x1c, 0x38, 0x62, 0x2b, 0x67, 0x82, 0x5a, 0xde, 0x04, 0x6b, 0x00, 0xf2, 0xc8, 0xbe, 0x1b, 0xef,
+	0x77, 0xeb, 0x82, 0x54, 0x43, 0x40, 0x1e, 0xb9, 0xf1, 0x3e, 0xfd, 0xa5, 0x11, 0xa4, 0xa8, 0x04,
+	0x4c, 0x96, 0xa7, 0xd4, 0xd6, 0x1d, 0xa8, 0x45, 0x52, 0xbf, 0xca, 0xc5, 0x97, 0x53, 0x16, 0x95,
+	0xe2, 0x1d, 0x7d, 0xe2, 0x34, 0xe9, 0xf2, 0x3a, 0x34, 0x3f, 0xf4, 0x03, 0xa6, 0x03, 0xef, 0x3c,
+	0x2c, 0x88, 0x5d, 0xe9, 0xa3, 0x6d, 0x47, 0xad, 0xe8, 0x36, 0x80, 0x3c, 0x26, 0xf2, 0xd1, 0x34,
+	0x5e, 0xeb, 0x55, 0x1a, 0x28, 0x4d, 0x6b, 0xe0, 0x7b, 0x50, 0xbb, 0xdf, 0xef, 0x87, 0x93, 0x60,
+	0x5e, 0x76, 0xea, 0x42, 0x6d, 0xd7, 0x1d, 0xba, 0x41, 0x12, 0x68, 0x7a, 0x49, 0xdf, 0x82, 0xe5,
+	0x6d, 0x76, 0xa4, 0x30, 0x68, 0xde, 0x5f, 0x03, 0x18, 0xbb, 0x71, 0x3c, 0xde, 0x8f, 0xdc, 0x58,
+	0x67, 0x5d, 0x03, 0x42, 0xcf, 0xc1, 0xca, 0x96, 0x1f, 0x73, 0xf5, 0x2b, 0x9d, 0x6b, 0xe8, 0x7b,
+	0xd0, 0x54, 0x20, 0xdc, 0x25, 0x6f, 0x40, 0xdd, 0x55, 0x27, 0x54, 0xb2, 0x35, 0x34, 0xac, 0x29,
+	0x26, 0x47, 0xe8, 0xd7, 0x16, 0x74, 0x1e, 0x8f, 0xc6, 0x61, 0xc4, 0xa7, 0xb8, 0xb9, 0x0a, 0xcd,
+	0x71, 0xe4, 0x1f, 0xba, 0x9c, 0xed, 0x1c, 0x30, 0x99, 0xc7, 0x1a, 0x8f, 0xce, 0x38, 0xa0, 0x80,
+	0x4f, 0xd8, 0x09, 0xb1, 0xa1, 0x76, 0xc0, 0x4e, 0xf6, 0xfc, 0xa1, 0x92, 0xef, 0xd1, 0x19, 0x47,
+	0x03, 0xa6, 0x84, 0x29, 0x4f, 0x0b, 0x83, 0xee, 0x12, 0xb0, 0xa3, 0x1d, 0xe3, 0x8c, 0xec, 0x3f,
+	0xda, 0x01, 0x3b, 0x7a, 0x9a, 0x00, 0x1f, 0x54, 0xa1, 0x7c, 0xc0, 0x4e, 0xe8, 0x11, 0x74, 0x1e,
+	0x1e, 0x17, 0x30, 0x39, 0x5b, 0xf7, 0x59, 0xfa, 0xa5, 0x53, 0xd0, 0x2f, 0x17, 0xd0, 0x47, 0x3b,
+	0x3f, 0x51, 0x12, 0xcd, 0xb5, 0x73, 0x46, 0x0f, 0x89, 0x16, 0xe8, 0x10, 0x3a, 0x9f, 0x04, 0xe8,
+	0x34, 0xff, 0x35, 0xbe, 0x6d, 0xa8, 0x7b, 0x93, 0x48, 0x74, 0x87, 0x82, 0xe3, 0xb6, 0x93, 0xac,
+	0xe9, 0x5d, 0x20, 0x5b, 0xdf, 0x80, 0x16, 0xfd, 0x3e, 0xb4, 0x9e, 0x71, 0x97, 0xb3, 0x57, 0x73,
+	0xd5, 0x81, 0xaa, 0xf0, 0x7d, 0xc5, 0x90, 0x5c, 0x50, 0x07, 0x16, 0x9f, 0xf1, 0x30, 0x72, 0x07,
+	0xec, 0x54, 0x95, 0x1a, 0xdd, 0x48, 0x55, 0xea, 0x03, 0x76, 0x92, 0xe2, 0x2c, 0x9b, 0x38, 0xaf,
+	0x41, 0xed, 0x81, 0x0c, 0x12, 0x33, 0x7c, 0xac, 0x6c, 0xf8, 0xac, 0x41, 0x75, 0x1b, 0x1b, 0xd2,
+	0xb4, 0x4d, 0x95, 0x11, 0x2c, 0x17, 0xd4, 0x86, 0xca, 0x86, 0xea, 0x21, 0x45, 0x6f, 0x69, 0xa5,
+	0x7d, 0x28, 0xbd, 0x0c, 0x35, 0xc5, 0x73, 0xda, 0xf9, 0x5a, 0x46, 0xe7, 0x4b, 0x3f, 0x04, 0x62,
+	0xa4, 0x36, 0x2d, 0xd8, 0x29, 0xb3, 0x5b, 0xae, 0x1f, 0xa1, 0x7f, 0x2e, 0x41, 0xd3, 0xc0, 0x77,
+	0x5a, 0x44, 0xba, 0xc7, 0x2f, 0x19, 0x3d, 0xfe, 0x22, 0x94, 0x78, 0xa8, 0xb4, 0x55, 0xe2, 0xe1,
+	0x69, 0x3a, 0x77, 0xad, 0x12, 0xdd, 0x07, 0x2c, 0xcc, 0xe8, 0x03, 0x6a, 0xf9, 0x4b, 0x81, 0x1f,
+	0x8c, 0x27, 0x5c, 0xa5, 0x7e, 0xb9, 0xc8, 0xa5, 0xcd, 0xc6, 0xab, 0xd2, 0x26, 0x4c, 0xa5, 0x4d,
+	0xa3, 0x3b, 0x6b, 0x66, 0xba, 0xb3, 0x6b, 0xaa, 0x3b, 0x6b, 0x15, 0x57, 0x67, 0xb1, 0x49, 0x3f,
+	0x86, 0xd6, 0x03, 0xc4, 0xa4, 0x6d, 0x92, 0x38, 0x90, 0x65, 0x38, 0x10, 0xb9, 0x03, 0xcb, 0x7b,
+	0x93, 0xe1, 0x70, 0xc7, 0xd0, 0xa7, 0xac, 0xcc, 0x75, 0x67, 0x09, 0x37, 0x0c, 0x63, 0xc4, 0x94,
+	0xc0, 0xd2, 0xc6, 0xbe, 0xeb, 0x07, 0x8f, 0x98, 0xeb, 0xe9, 0x7c, 0xfa, 0xef, 0x12, 0x54, 0x05,
+	0x1d, 0xe4, 0x36, 0x53, 0x20, 0xd4, 0x0a, 0x8d, 0x63, 0x54, 0x05, 0xf1, 0x4d, 0x2e, 0x43, 0x73,
+	0xec, 0x46, 0x2c, 0xe0, 0x52, 0xf2, 0x24, 0xe1, 0x21, 0x48, 0x88, 0xde, 0x81, 0xea, 0xc8, 0x0f,
+	0x54, 0xc7, 0xd3, 0x70, 0xe4, 0x82, 0xac, 0x42, 0x83, 0xfb, 0x23, 0x16, 0x73, 0x77, 0x34, 0x56,
+	0x16, 0x4b, 0x01, 0x98, 0x0c, 0x3c, 0x7f, 0x6f, 0xcf, 0xef, 0x4f, 0x86, 0xfc, 0x44, 0x18, 0xaf,
+	0xe1, 0x18, 0x90, 0xec, 0xad, 0x4f, 0x96, 0xf1, 0xf4, 0xd6, 0x67, 0xf6, 0x1f, 0xf5, 0x6c, 0xff,
+	0xb1, 0x06, 0xc0, 0x8e, 0x79, 0xe4, 0xee, 0x88, 0xf6, 0xac, 0x21, 0xad, 0x24, 0x20, 0xef, 0xbb,
+	0xdc, 0x45, 0xf9, 0x62, 0xff, 0x4b, 0x26, 0xcc, 0x57, 0x71, 0xc4, 0x37, 0xb9, 0x01, 0x67, 0xb3,
+	0x7e, 0x1b, 0x77, 0x9b, 0xa2, 0xd9, 0x5b, 0xcc, 0x38, 0x6e, 0x4c, 0xbe, 0x0b, 0xad, 0x8c, 0xea,
+	0xa5, 0x49, 0xcf, 0xe5, 0x1b, 0x59, 0x8c, 0xae, 0xcc, 0x51, 0x7a, 0x05, 0x16, 0x9e, 0xcb, 0xce,
+	0x31, 0xed, 0x28, 0x2d, 0xb3, 0xa3, 0xa4, 0xbf, 0xb3, 0xa0, 0xb1, 0x15, 0x0e, 0x3e, 0xf0, 0x87,
+	0x5c, 0x2a, 0x4f, 0xa5, 0x17, 0xa6, 0x0f, 0xa6, 0x00, 0x72, 0x33, 0xd3, 0x95, 0x36, 0xd7, 0x97,
+	0x0c, 0x16, 0x04, 0x5c, 0x63, 0x4d, 0x7b, 0xc6, 0xb2, 0xd9, 0x33, 0xaa, 0x58, 0xae, 0xa4, 0x77,
+	0x8b, 0x35, 0x00, 0x0c, 0xc4, 0x1d, 0xe9, 0x75, 0xb2, 0x01, 0x6b, 0x20, 0x44, 0xb8, 0x0b, 0x5d,
+	0x86, 0xb3, 0xdb, 0xec, 0x08, 0x5d, 0x29, 0xa9, 0xcd, 0xab, 0x60, 0x3f, 0x95, 0xd7, 0x2a, 0xd3,
+	0xed, 0xf4, 0xee, 0x3d, 0x80, 0x67, 0xe1, 0x24, 0xea, 0xb3, 0x0f, 0xfc, 0x61, 0xf1, 0x75, 0xab,
+	0x0b, 0x35, 0x6c, 0xf0, 0x90, 0x37, 0x55, 0x59, 0xd4, 0x92, 0xfe, 0xc1, 0x82, 0x45, 0x75, 0x95,
+	0xd0, 0xf1, 0x70, 0x17, 0x6a, 0xb1, 0x40, 0xa7, 0x0b, 0x7f, 0x27, 0x95, 0x38, 0xa5, 0xe3, 0xe8,
+	0x43, 0x58, 0x4a, 0xf0, 0x1a, 0x33, 0x42, 0x53, 0xcb, 0x00, 0x49, 0xd6, 0xe4, 0x1a, 0xb4, 0xf5,
+	0xf7, 0x4e, 0x34, 0x09, 0x62, 0x55, 0x6b, 0x5a, 0x1a, 0xe8, 0x4c, 0x82, 0x18, 0xf5, 0xaf, 0xdb,
+	0x4f, 0x7d, 0xb1, 0x4a, 0x01, 0xf4, 0xaf, 0x62, 0x58, 0x20, 0x57, 0xf7, 0x23, 0xee, 0xef, 0xb9,
+	0x7d, 0xd1, 0x9f, 0x49, 0xf2, 0x4a, 0x4c, 0xb5, 0x4a, 0x84, 0x2f, 0x19, 0xc2, 0x2b, 0x03, 0x94,
+	0x53, 0x03, 0x4c, 0x0f, 0x0c, 0x1a, 0xc6, 0xc0, 0xe0, 0x16, 0x2c, 0x45, 0x93, 0x00, 0x63, 0x67,
+	0x27, 0x39, 0xa3, 0x7a, 0x64, 0x05, 0x7f, 0xa0, 0x8f, 0xda, 0x50, 0x1f, 0x31, 0xee, 0x0a, 0xe7,
+	0x97, 0x41, 0x95, 0xac, 0x71, 0xef, 0xc8, 0x8d, 0x02, 0x3f, 0x18, 0xc4, 0xdd, 0x9a, 0x10, 0x29,
+	0x59, 0x53, 0x06, 0xed, 0x44, 0xe5, 0xa2, 0x8f, 0x7c, 0xd7, 0x54, 0x80, 0xd4, 0x79, 0xe6, 0xa6,
+	0x97, 0x15, 0xde, 0x50, 0x4e, 0x86, 0x4c, 0x69, 0x8a, 0xcc, 0x5f, 0x2c, 0x58, 0x91, 0x93, 0x08,
+	0x69, 0x35, 0x6d, 0xdf, 0x75, 0x74, 0x06, 0x41, 0x5e, 0xdd, 0x2a, 0xbb, 0xb9, 0x5b, 0xa5, 0x3a,
+	0xea, 0xe8, 0x83, 0x85, 0x7a, 0x2d, 0x1a, 0xc5, 0x18, 0xd7, 0xde, 0xca, 0x37, 0x1b, 0xad, 0x54,
+	0x8b, 0x47, 0x2b, 0x0b, 0xc6, 0x68, 0xe5, 0x17, 0x25, 0x68, 0x69, 0x65, 0x88, 0xbb, 0xc2, 0x0c,
+	0x57, 0xd7, 0x28, 0x4b, 0x59, 0x94, 0x79, 0x3f, 0xb8, 0x06, 0x6d, 0x6d, 0x63, 0x99, 0x6e, 0xa5,
+	0x33, 0xb4, 0x34, 0x50, 0x24, 0x5c, 0xa3, 0x17, 0xa9, 0x66, 0x7b, 0x91, 0x7c, 0x0d, 0x5e, 0x38,
+	0xcd, 0xb0, 0xa6, 0xe0, 0x9e, 0x84, 0xdd, 0x98, 0xb0, 0x14, 0x8b, 0x54, 0xa9, 0x4c, 0xd6, 0x89,
+	0x26, 0x1a, 0x86, 0x26, 0xfe, 0x64, 0xc1, 0x39, 0xd9, 0x6d, 0x6b, 0x7d, 0x7c, 0x9b, 0x19, 0x87,
+	0x56, 0x5f, 0xb9, 0x58, 0x7d, 0x95, 0x62, 0x8b, 0x54, 0x53, 0x3e, 0xc8, 0x77, 0xa0, 0xee, 0x2a,
+	0xaf, 0x54, 0x63, 0x8e, 0x79, 0x7e, 0x9b, 0x9c, 0xa5, 0xef, 0x41, 0x07, 0x2f, 0x19, 0xfa, 0x44,
+	0x32, 0xef, 0x28, 0x32, 0xe8, 0x12, 0x94, 0xb9, 0x3b, 0xd0, 0x7c, 0x73, 0x77, 0x40, 0xdf, 0x4f,
+	0xdd, 0x00, 0xb1, 0x90, 0xb7, 0xf3, 0xe1, 0x73, 0x3e, 0xcf, 0x06, 0x7a, 0x8c, 0x99, 0x57, 0xde,
+	0x04, 0xb2, 0xc9, 0x72, 0xfa, 0xb3, 0xa1, 0xae, 0x8f, 0x28, 0x2e, 0x92, 0x35, 0xfd, 0x18, 0x96,
+	0x1f, 0x06, 0xe8, 0x17, 0xe6, 0x60, 0x2d, 0x3f, 0xb6, 0x4c, 0x9b, 0x93, 0x52, 0xe1, 0xe8, 0xc8,
+	0x88, 0x17, 0x7a, 0x15, 0x9a, 0x12, 0xa5, 0x87, 0x38, 0x93, 0x49, 0x87, 0x95, 0x4e, 0x3a, 0xe8,
+	0x33, 0x8c, 0x62, 0x3c, 0xf2, 0x91, 0x18, 0x6d, 0x7d, 0x2b, 0xba, 0xd3, 0xe3, 0x13, 0x7a, 0x0f,
+	0x88, 0x44, 0xfa, 0x38, 0x98, 0x8b, 0x53, 0xff, 0xb6, 0x64, 0xfc, 0xf6, 0x09, 0xb4, 0xe4, 0x6f,
+	0x3d, 0xf1, 0x63, 0x83, 0xae, 0x55, 0xd8, 0x8c, 0x95, 0xe6, 0x35, 0x63, 0x4f, 0x61, 0x49, 0x22,
+	0xc3, 0xb9, 0xc2, 0x3c, 0xd1, 0x4e, 0x3b, 0x19, 0xa2, 0x4f, 0x00, 0x14, 0x7b, 0x38, 0x6d, 0x4a,
+	0x6a, 0xb2, 0x55, 0x3c, 0xc7, 0x29, 0xcd, 0x9f, 0xe3, 0x1c, 0x02, 0xd9, 0x88, 0x98, 0xcb, 0xd9,
+	0xf3, 0xf0, 0x80, 0x05, 0xf3, 0xdc, 0x94, 0x40, 0x25, 0x0a, 0x93, 0x9b, 0x9b, 0xf8, 0x46, 0x67,
+	0x4a, 0xee, 0xd0, 0xd2, 0xea, 0xc9, 0xfa, 0x15, 0x45, 0xef, 0xb7, 0x38, 0xa9, 0x47, 0x92, 0x22,
+	0xcf, 0x2d, 0x42, 0x29, 0x69, 0xf0, 0x4b, 0xbe, 0x37, 0x2b, 0x1b, 0x0b, 0xfa, 0xe5, 0x19, 0xf4,
+	0x2b, 0xf3, 0xe8, 0x57, 0xa7, 0xe8, 0x8b, 0x86, 0x41, 0xc8, 0x2d, 0x73, 0x5a, 0xd9, 0xd1, 0x4b,
+	0xfa, 0x01, 0x54, 0x05, 0x63, 0xe4, 0x06, 0x54, 0xfc, 0x60, 0x2f, 0xec, 0x5a, 0xb9, 0x3c, 0xaf,
+	0xf9, 0x76, 0xc4, 0x01, 0x34, 0x01, 0x47, 0x90, 0xbe, 0xf4, 0x89, 0x05, 0x7d, 0x1d, 0x88, 0xc3,
+	0x0e, 0xc3, 0x83, 0xac, 0x66, 0xa7, 0x24, 0xa5, 0x2b, 0xb0, 0x8c, 0x21, 0x2e, 0xce, 0x24, 0xfd,
+	0xce, 0xbb, 0x4a, 0x37, 0xb8, 0x43, 0xee, 0xa0, 0x6b, 0xe0, 0xae, 0x8a, 0xfc, 0x42, 0x46, 0xd4,
+	0x91, 0xf5, 0xdf, 0x77, 0xa0, 0xfe, 0x50, 0x6d, 0x93, 0x2f, 0x60, 0x41, 0x96, 0x47, 0x32, 0x67,
+	0xac, 0x6a, 0x1b, 0xc5, 0x31, 0x3b, 0xd6, 0xa7, 0xaf, 0x7d, 0xf5, 0xb7, 0x7f, 0xfe, 0xa6, 0xd4,
+	0xa5, 0x2b, 0xbd, 0xc3, 0xff, 0xef, 0x69, 0xd5, 0xf5, 0x64, 0xde, 0xbe, 0x67, 0xdd, 0x26, 0x9f,
+	0x43, 0x4d, 0x61, 0x23, 0x33, 0x2b, 0xac, 0x7d, 0xa1, 0x60, 0x07, 0x7b, 0x02, 0x7a, 0x59, 0x60,
+	0xbf, 0x78, 0xcf, 0xba, 0x4d, 0x3b, 0x19, 0x02, 0xba, 0x24, 0x07, 0x18, 0x86, 0x69, 0x75, 0x27,
+	0x6b, 0xd3, 0x8c, 0x66, 0xaa, 0xfe, 0x1c, 0x39, 0xae, 0x0b, 0x4a, 0x97, 0xa9, 0x5d, 0x20, 0x47,
+	0x4f, 0xf6, 0x55, 0x28, 0xce, 0x67, 0xb0, 0xe4, 0xb0, 0x81, 0x1f, 0x73, 0x16, 0x25, 0x6f, 0x36,
+	0x33, 0xd2, 0xac, 0x3d, 0x03, 0x4e, 0x2f, 0x08, 0x52, 0xcb, 0x28, 0x54, 0x0b, 0xa9, 0x45, 0x02,
+	0x61, 0x74, 0x42, 0x7c, 0x58, 0xcc, 0xd6, 0x33, 0x72, 0x39, 0x45, 0x51, 0x58, 0xe9, 0x66, 0xd2,
+	0x50, 0x66, 0x41, 0x1a, 0x2b, 0x26, 0x8d, 0x9e, 0x2f, 0xd0, 0x90, 0xcf, 0xa1, 0x9d, 0xa9, 0x3d,
+	0xe4, 0x35, 0x63, 0xb8, 0x59, 0x50, 0x94, 0x8a, 0x08, 0xe1, 0x39, 0xda, 0x11, 0x84, 0x16, 0x49,
+	0x56, 0x12, 0x0f, 0x9a, 0x46, 0x59, 0x21, 0xab, 0xe9, 0x8f, 0xf3, 0xd5, 0x66, 0xa6, 0x0c, 0xca,
+	0xf8, 0xe4, 0x42, 0x46, 0x80, 0x17, 0xda, 0x38, 0x2f, 0xc9, 0x67, 0x50, 0x11, 0x05, 0xc3, 0xb8,
+	0x0f, 0x19, 0x45, 0xc9, 0xee, 0x4c, 0x83, 0x85, 0x4b, 0xfd, 0x9f, 0xc0, 0x7a, 0x85, 0x5e, 0xca,
+	0x18, 0xfa, 0x85, 0x6a, 0x10, 0x5e, 0xf6, 0xfa, 0xee, 0x70, 0x88, 0x96, 0x1e, 0x42, 0x5d, 0x5f,
+	0x33, 0xc8, 0xec, 0x97, 0x03, 0xfb, 0x62, 0xe1, 0x5d, 0x4c, 0xf0, 0x7f, 0x5b, 0x50, 0x7a, 0x9d,
+	0x5e, 0x9e, 0x41, 0x49, 0x37, 0x48, 0x48, 0xcd, 0x83, 0xa6, 0xf1, 0xf2, 0x61, 0x2a, 0x2c, 0xff,
+	0x20, 0x62, 0x1b, 0xf2, 0x1a, 0xcf, 0x13, 0xf4, 0x8a, 0xa0, 0x67, 0xa3, 0xcd, 0xcf, 0x65, 0x48,
+	0x32, 0x75, 0x82, 0x7c, 0x06, 0x90, 0xd6, 0x6e, 0x72, 0xc9, 0x20, 0x32, 0x5d, 0xd1, 0xed, 0x73,
+	0xd3, 0x9b, 0xa2, 0x36, 0xd3, 0x8b, 0x82, 0xc6, 0x0a, 0xd2, 0x58, 0x44, 0x1a, 0xee, 0xae, 0xdf,
+	0x63, 0x62, 0x9f, 0x30, 0x68, 0x99, 0x25, 0x3a, 0x1b, 0x8a, 0xb9, 0xd2, 0x3d, 0xc3, 0x3a, 0xd3,
+	0x32, 0x20, 0x7e, 0x4f, 0xfc, 0xba, 0x27, 0x1f, 0xb5, 0x50, 0x53, 0x46, 0xd1, 0x36, 0x35, 0x95,
+	0xaf, 0xe5, 0xf6, 0xf9, 0xe9, 0x5d, 0x59, 0xad, 0xb5, 0x6b, 0xd1, 0xce, 0x14, 0x0d, 0x31, 0x9a,
+	0x41, 0x7b, 0xfc, 0x18, 0x1a, 0x49, 0x45, 0x36, 0x33, 0xe3, 0x74, 0x99, 0xb6, 0x3b, 0xd3, 0x7b,
+	0x58, 0x70, 0xe9, 0x9a, 0xc0, 0x7f, 0x81, 0x92, 0x29, 0xfc, 0xc3, 0x70, 0x80, 0xd8, 0xb7, 0xa1,
+	0x82, 0x03, 0x74, 0xd3, 0x71, 0x8d, 0xb9, 0xbb, 0xdd, 0x99, 0x06, 0x0b, 0xd5, 0x28, 0xd5, 0x4b,
+	0xbd, 0xf7, 0x71, 0xd0, 0xd2, 0xc3, 0xb1, 0x07, 0xe2, 0xfb, 0x02, 0x60, 0x93, 0x71, 0x3d, 0xea,
+	0x33, 0x84, 0x36, 0x27, 0x92, 0xb6, 0x31, 0xba, 0x56, 0x47, 0x75, 0xd6, 0x23, 0x6b, 0x82, 0x4f,
+	0xe9, 0x98, 0x86, 0x87, 0xaa, 0x11, 0x21, 0xf9, 0x14, 0xea, 0x9b, 0x8c, 0x6f, 0x87, 0xf3, 0xb0,
+	0x1b, 0xdd, 0x83, 0x38, 0x48, 0xaf, 0x09, 0xdc, 0x6b, 0xe4, 0x52, 0x31, 0x6e, 0x39, 0x4a, 0xfb,
+	0x04, 0x6a, 0x22, 0x23, 0x78, 0xb3, 0x11, 0x2f, 0x9a, 0xe9, 0xc1, 0x63, 0x94, 0x0a, 0xbc, 0xab,
+	0xc4, 0x2e, 0xc6, 0x2b, 0x7c, 0x71, 0x1f, 0x5a, 0x9b, 0x8c, 0xab, 0xd9, 0xe4, 0x7d, 0x6e, 0x96,
+	0x9e, 0xec, 0x90, 0xd5, 0x5e, 0xce, 0xed, 0xd0, 0x3b, 0x82, 0xc0, 0x75, 0x72, 0xad, 0x98, 0x40,
+	0x2c, 0x8f, 0xf5, 0x5e, 0x1c, 0xb0, 0x93, 0x97, 0x24, 0x84, 0xc5, 0x4d, 0xc6, 0xcd, 0xa1, 0xe4,
+	0x6a, 0xf1, 0x74, 0x26, 0x1f, 0x57, 0xc6, 0x2e, 0xbd, 0x29, 0x68, 0x52, 0x72, 0x05, 0x69, 0x1a,
+	0x37, 0xa7, 0xde, 0x8b, 0xec, 0xe5, 0xea, 0x25, 0xf9, 0x12, 0xce, 0x65, 0x09, 0xea, 0x17, 0xb6,
+	0xf9, 0x74, 0xf3, 0x2f, 0x43, 0xf4, 0x4d, 0x41, 0xf3, 0x36, 0xb9, 0xf9, 0x2a, 0x9a, 0x3d, 0xfd,
+	0x84, 0xb4, 0x2d, 0xfc, 0x40, 0xcd, 0xf3, 0x0c, 0x6f, 0x32, 0x06, 0x89, 0xf6, 0xd9, 0x29, 0xb8,
+	0xf6, 0x5b, 0xb2, 0x8c, 0x64, 0xc4, 0x7d, 0xaf, 0xf7, 0x42, 0xfc, 0x79, 0x49, 0x9e, 0x09, 0x33,
+	0x25, 0x43, 0xc3, 0x4c, 0x0b, 0x32, 0x35, 0x49, 0xcc, 0xe3, 0x3d, 0x2f, 0xf0, 0x2e, 0x11, 0x23,
+	0x1e, 0xf6, 0x11, 0xc9, 0x73, 0x68, 0x3f, 0x9b, 0xec, 0xc6, 0xfd, 0xc8, 0xdf, 0xc5, 0x40, 0x8d,
+	0xc9, 0x4a, 0xe6, 0xdd, 0x4e, 0x8e, 0xbb, 0xec, 0xec, 0x63, 0x9e, 0x0e, 0x58, 0xcc, 0x3b, 0x22,
+	0x66, 0xf1, 0x6d, 0xaf, 0x17, 0x6b, 0x34, 0x6f, 0x5a, 0xc4, 0x83, 0xe5, 0x04, 0xab, 0x1e, 0x4c,
+	0x99, 0x75, 0x61, 0x6a, 0x58, 0x95, 0x67, 0xf7, 0xaa, 0xa0, 0x70, 0x89, 0x5c, 0xcc, 0xb2, 0x9b,
+	0xa5, 0xf2, 0x95, 0x05, 0xab, 0x09, 0x99, 0x82, 0x61, 0x17, 0x79, 0x3d, 0x45, 0x3b, 0x7b, 0x16,
+	0x36, 0xcb, 0xc9, 0x32, 0x11, 0x29, 0x59, 0x50, 0xff, 0xa7, 0x60, 0x32, 0xb1, 0xfe, 0x8f, 0x0a,
+	0xd4, 0xf5, 0xbb, 0x18, 0x79, 0x0e, 0x90, 0x3e, 0xae, 0x99, 0x25, 0x23, 0xf7, 0xe4, 0x66, 0xe7,
+	0x9f, 0xc6, 0xb4, 0x8d, 0x50, 0xad, 0x4d, 0x11, 0x4d, 0x0a, 0xcf, 0x0f, 0xa1, 0x65, 0xbe, 0xbe,
+	0x99, 0xb5, 0xa2, 0xe0, 0x55, 0xce, 0x94, 0xc7, 0x78, 0x9d, 0xa3, 0x2b, 0x02, 0x7b, 0x9b, 0x64,
+	0x50, 0xbb, 0xd0, 0xce, 0x3c, 0xc1, 0x99, 0x9d, 0x4d, 0xd1, 0xdb, 0x5c, 0x11, 0xdb, 0xd9, 0xf4,
+	0x2d, 0x81, 0xaa, 0x73, 0xc2, 0x74, 0x7b, 0x00, 0xed, 0x87, 0xc7, 0x33, 0x48, 0x3c, 0x3c, 0x9e,
+	0x4f, 0x42, 0x3d, 0x80, 0xd1, 0x1b, 0x82, 0xc4, 0x55, 0xba, 0x6a, 0x92, 0x48, 0x93, 0x0c, 0x3b,
+	0x36, 0x88, 0x65, 0x5e, 0xbd, 0x4c, 0x62, 0x45, 0xcf, 0x61, 0x45, 0xf2, 0xbc, 0x82, 0xd8, 0x44,
+	0xa0, 0x41, 0x62, 0x0c, 0x9a, 0xc6, 0xa3, 0x97, 0x99, 0x52, 0xb6, 0x4e, 0x45, 0x28, 0xd3, 0x45,
+	0xe7, 0x09, 0x29, 0x32, 0xeb, 0xbf, 0x2a, 0xe1, 0x70, 0x1a, 0x2f, 0x23, 0xc4, 0x81, 0xa6, 0x71,
+	0xb7, 0x34, 0x29, 0xe6, 0xaf, 0x9c, 0x66, 0x50, 0x09, 0xb8, 0xee, 0x3e, 0xd1, 0xbf, 0x1a, 0x48,
+	0x52, 0xdc, 0x70, 0xc8, 0xa7, 0xd0, 0x34, 0x6e, 0x55, 0x26, 0xce, 0xfc, 0x65, 0xcb, 0x2e, 0xba,
+	0x2a, 0x69, 0xbf, 0xbd, 0xbd, 0x98, 0x20, 0xed, 0xbd, 0xc0, 0xe4, 0xfb, 0x31, 0x40, 0x7a, 0x13,
+	0x33, 0xa3, 0x21, 0x77, 0x3f, 0xcb, 0xe1, 0x15, 0x1e, 0xbb, 0x2c, 0xf0, 0x36, 0x49, 0xca, 0xec,
+	0x03, 0xf8, 0x51, 0xf2, 0x8f, 0x6b, 0xbb, 0x0b, 0xe2, 0x3f, 0xd4, 0xde, 0xfa, 0xcf, 0x00, 0x05,
+	0x83, 0x5e, 0x50, 0xe8, 0x26, 0x00, 0x00,
 }
@@ -4,10 +4,27 @@ import flag "github.com/spf13/pflag"
 
 var (
 	APIServiceFlags = flag.NewFlagSet("api", flag.ExitOnError)
+	ClientFlags     = flag.NewFlagSet("client", flag.ExitOnError)
 
-	host     string
-	port     int
-	grpcPort int
+	host       string
+	port       int
+	grpcPort   int
+	grpcSocket string
+
+	tlsCert   string
+	tlsKey    string
+	tlsCA     string
+	plaintext bool
+
+	tlsClientCA string
+	tlsRoles    string
+
+	tokenAuth bool
+	tokenFile string
+
+	clientCert  string
+	clientKey   string
+	clientToken string
 )
 
 func init() {
@@ -26,6 +43,81 @@ func init() {
 	APIServiceFlags.IntVar(&grpcPort,
 		"grpcport",
 		9000,
-		"gRPC service listening port",
+		"gRPC service listening port (0 = shared with the API service port)",
+	)
+
+	APIServiceFlags.StringVar(&grpcSocket,
+		"grpcsocket",
+		"",
+		"Unix socket to serve plaintext gRPC on instead of the gRPC port",
+	)
+
+	// TLS settings
+	APIServiceFlags.StringVar(&tlsCert,
+		"tls-cert",
+		"",
+		"TLS certificate file of the API service (default = self-signed, inside the datadir)",
+	)
+
+	APIServiceFlags.StringVar(&tlsKey,
+		"tls-key",
+		"",
+		"TLS private key file of the API service",
+	)
+
+	APIServiceFlags.StringVar(&tlsCA,
+		"tls-ca",
+		"",
+		"CA certificate file the API service is verified against (default = the service certificate)",
+	)
+
+	APIServiceFlags.BoolVar(&plaintext,
+		"plaintext",
+		false,
+		"Serve without TLS (loopback addresses only)",
+	)
+
+	// Client authentication settings
+	APIServiceFlags.StringVar(&tlsClientCA,
+		"tls-client-ca",
+		"",
+		"CA certificate file to require and verify client certificates against",
+	)
+
+	APIServiceFlags.StringVar(&tlsRoles,
+		"tls-roles",
+		"",
+		"JSON file mapping client certificate common names (or \"*\") to read-only, transact, deploy or admin (default = admin for all)",
+	)
+
+	APIServiceFlags.BoolVar(&tokenAuth,
+		"token-auth",
+		false,
+		"Require bearer tokens from clients without a verified certificate",
+	)
+
+	APIServiceFlags.StringVar(&tokenFile,
+		"token-file",
+		"",
+		"File the hashed API tokens are stored in (default = inside the datadir)",
+	)
+
+	// Client credentials
+	ClientFlags.StringVar(&clientCert,
+		"cert",
+		"",
+		"TLS certificate file to authenticate to the API service with",
+	)
+
+	ClientFlags.StringVar(&clientKey,
+		"key",
+		"",
+		"TLS private key file of the client certificate",
+	)
+
+	ClientFlags.StringVar(&clientToken,
+		"token",
+		"",
+		"Bearer token to authenticate to the API service with",
 	)
 }
@@ -2,9 +2,16 @@ package api
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -16,44 +23,156 @@ import (
 	"github.com/tylerb/graceful"
 )
 
+// shutdownTimeout is how long in-flight requests are given to complete when
+// the service is stopped.
+const shutdownTimeout = 10 * time.Second
+
 type Service interface {
+	// Start opens the listeners and serves in the background.
 	Start() error
+	// Stop shuts the service down, draining in-flight requests first.
 	Stop() error
+	// Wait blocks until the service is stopped and returns the error it
+	// failed with, if any.
+	Wait() error
+	// Done is closed once the service is stopped.
+	Done() <-chan struct{}
 }
 
-func New(controller Controller) Service {
-	opts := []grpc.ServerOption{
-		grpc.Creds(credentials.NewClientTLSFromCert(demoCertPool, fmt.Sprintf("%s:%d", host, port)))}
+// New creates the API service. Self-signed certificates are kept in dataDir.
+func New(controller Controller, dataDir string) (Service, error) {
+	var (
+		opts    []grpc.ServerOption
+		auth    *authorizer
+		clients *x509.CertPool
+		tokens  *tokenStore
+		err     error
+	)
+	if tlsClientCA != "" {
+		if plaintext {
+			return nil, errors.New("client certificates cannot be verified in plaintext mode")
+		}
+		if clients, err = loadCertPool(tlsClientCA); err != nil {
+			return nil, fmt.Errorf("failed to load client CA certificate: %v", err)
+		}
+	}
+	if tokenAuth {
+		file := tokenFile
+		if file == "" {
+			file = filepath.Join(dataDir, tokenFileName)
+		}
+		if tokens, err = openTokenStore(file); err != nil {
+			return nil, fmt.Errorf("failed to open token file: %v", err)
+		}
+	}
+	if clients != nil || tokens != nil {
+		if auth, err = newAuthorizer(clients != nil, tlsRoles, tokens, controller); err != nil {
+			return nil, fmt.Errorf("failed to load client roles: %v", err)
+		}
+		opts = append(opts,
+			grpc.UnaryInterceptor(auth.unaryInterceptor),
+			grpc.StreamInterceptor(auth.streamInterceptor),
+		)
+	}
 
-	grpcServer := grpc.NewServer(opts...)
-	gw.RegisterEthereumServer(grpcServer, controller)
+	s := &service{
+		pipe: newPipeListener(),
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
 
+	// gRPC is served on the API service port, unless it has a listener of
+	// its own. Plaintext is only ever served locally.
+	var tlsConfig *tls.Config
+	switch {
+	case plaintext && !isLoopback(host):
+		return nil, errors.New("plaintext mode is only allowed on loopback addresses")
+	case grpcSocket != "":
+		s.grpcNetwork, s.grpcAddr = "unix", grpcSocket
+	case grpcPort > 0:
+		s.grpcNetwork, s.grpcAddr = "tcp", net.JoinHostPort(host, strconv.Itoa(grpcPort))
+	case plaintext:
+		return nil, errors.New("plaintext mode requires a gRPC port or socket")
+	}
+	if !plaintext {
+		certFile, keyFile, err := certFiles(dataDir)
+		if err != nil {
+			return nil, err
+		}
+		if s.certs, err = newCertReloader(certFile, keyFile); err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %v", err)
+		}
+		tlsConfig = &tls.Config{
+			GetCertificate: s.certs.GetCertificate,
+			NextProtos:     []string{"h2"},
+		}
+		if clients != nil {
+			// Clients of the gateway are authenticated by the gateway
+			// handler, the interceptors reject anyone else without one
+			tlsConfig.ClientCAs = clients
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	// The gateway and the socket go without TLS, and so does the gRPC port
+	// in plaintext mode
+	s.localServer = newGRPCServer(controller, tokens, opts...)
+	switch {
+	case s.grpcNetwork == "":
+		s.grpcServer = newGRPCServer(controller, tokens, opts...)
+	case s.grpcNetwork == "tcp" && tlsConfig != nil:
+		creds := credentials.NewTLS(tlsConfig)
+		s.grpcServer = newGRPCServer(controller, tokens, append(opts, grpc.Creds(creds))...)
+	}
+
+	// The gateway closes its connections once the context is cancelled
+	var ctx context.Context
+	ctx, s.closeGateway = context.WithCancel(context.Background())
+
+	dopts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDialer(s.pipe.Dial)}
 	mux := runtime.NewServeMux()
-	dcreds := credentials.NewTLS(&tls.Config{
-		ServerName: fmt.Sprintf("%s:%d", host, port),
-		RootCAs:    demoCertPool,
-	})
-	dopts := []grpc.DialOption{grpc.WithTransportCredentials(dcreds)}
-	err := gw.RegisterEthereumHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", host, port), dopts)
-	if err != nil {
-		return nil
+	for _, register := range []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		gw.RegisterEthereumHandlerFromEndpoint,
+		gw.RegisterAccountsHandlerFromEndpoint,
+		gw.RegisterTokensHandlerFromEndpoint,
+	} {
+		if err := register(ctx, mux, "pipe", dopts); err != nil {
+			s.closeGateway()
+			return nil, fmt.Errorf("failed to register gateway: %v", err)
+		}
 	}
 
-	s := &service{
-		server: &graceful.Server{
-			Timeout: 10 * time.Second,
-			Server: &http.Server{
-				Addr:    fmt.Sprintf("%s:%d", host, port),
-				Handler: grpcHandlerFunc(grpcServer, mux),
-				TLSConfig: &tls.Config{
-					Certificates: []tls.Certificate{*demoKeyPair},
-					NextProtos:   []string{"h2"},
-				},
-			},
+	var handler http.Handler = mux
+	if auth != nil {
+		handler = auth.gatewayHandler(mux)
+	}
+	if s.grpcNetwork == "" {
+		handler = grpcHandlerFunc(s.grpcServer, handler)
+	}
+
+	s.server = &graceful.Server{
+		Timeout:          shutdownTimeout,
+		NoSignalHandling: true,
+		Server: &http.Server{
+			Addr:    net.JoinHostPort(host, strconv.Itoa(port)),
+			Handler: handler,
 		},
 	}
+	s.server.TLSConfig = tlsConfig
+
+	return s, nil
+}
 
-	return s
+// newGRPCServer creates a gRPC server for the controller, along with the
+// token administration if token authentication is enabled.
+func newGRPCServer(controller Controller, tokens *tokenStore, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	gw.RegisterEthereumServer(server, controller)
+	gw.RegisterAccountsServer(server, controller)
+	if tokens != nil {
+		gw.RegisterTokensServer(server, &tokenService{store: tokens, contracts: controller})
+	}
+	return server
 }
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
@@ -73,14 +192,135 @@ func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Ha
 // ----------------------------------------------------------------------------
 
 type service struct {
-	server *graceful.Server
+	server      *graceful.Server
+	grpcServer  *grpc.Server  // Serves gRPC over TLS, nil if that is plaintext
+	localServer *grpc.Server  // Serves the gateway, and gRPC if that is plaintext
+	grpcNetwork string        // Network of the dedicated gRPC listener, if any
+	grpcAddr    string        // Address of the dedicated gRPC listener, if any
+	pipe        *pipeListener // Connects the gateway in-process
+	certs       *certReloader // Nil in plaintext mode
+
+	closeGateway context.CancelFunc
+	quit         chan struct{} // Closed when stopping
+	done         chan struct{} // Closed when stopped
+	stopOnce     sync.Once
+
+	mu  sync.Mutex
+	err error // First error the service failed with
 }
 
 func (s *service) Start() error {
-	return s.server.ListenAndServeTLSConfig(s.server.TLSConfig)
+	// Listen up front, so that failing to is reported right away
+	var grpcListener net.Listener
+	if s.grpcNetwork != "" {
+		if s.grpcNetwork == "unix" {
+			// A stale socket of an earlier run is in the way otherwise
+			os.Remove(s.grpcAddr)
+		}
+		l, err := net.Listen(s.grpcNetwork, s.grpcAddr)
+		if err != nil {
+			return err
+		}
+		grpcListener = l
+	}
+	l, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		if grpcListener != nil {
+			grpcListener.Close()
+		}
+		return err
+	}
+	if s.certs != nil {
+		l = tls.NewListener(l, s.server.TLSConfig)
+		go s.certs.watch(s.quit)
+	}
+
+	go s.serve(func() error { return s.localServer.Serve(s.pipe) })
+	if grpcListener != nil {
+		grpcServer := s.grpcServer
+		if grpcServer == nil {
+			grpcServer = s.localServer
+		}
+		go s.serve(func() error { return grpcServer.Serve(grpcListener) })
+	}
+	go s.serve(func() error { return s.server.Serve(l) })
+
+	return nil
 }
 
+// serve runs a server until it returns. Returning before the service is
+// stopped is a failure, which stops the rest of it.
+func (s *service) serve(fn func() error) {
+	err := fn()
+	select {
+	case <-s.quit:
+		return
+	default:
+	}
+	if err == nil {
+		err = errors.New("server stopped unexpectedly")
+	}
+
+	s.mu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+	s.Stop()
+}
+
+// Stop drains the REST and gRPC requests in flight within the shutdown
+// timeout, after which the remaining ones are cut off. The gateway goes last,
+// as the REST requests are served through it.
 func (s *service) Stop() error {
-	s.server.Stop(10 * time.Second)
+	s.stopOnce.Do(func() {
+		close(s.quit)
+
+		s.server.Stop(shutdownTimeout)
+		deadline := time.After(shutdownTimeout)
+		select {
+		case <-s.server.StopChan():
+		case <-deadline:
+		}
+
+		if s.grpcServer != nil {
+			stopGRPC(s.grpcServer, deadline)
+		}
+		s.closeGateway()
+		stopGRPC(s.localServer, deadline)
+		s.pipe.Close()
+
+		if s.grpcNetwork == "unix" {
+			os.Remove(s.grpcAddr)
+		}
+		close(s.done)
+	})
 	return nil
 }
+
+// stopGRPC stops a gRPC server gracefully, or forcibly once the deadline
+// passes.
+func stopGRPC(server *grpc.Server, deadline <-chan time.Time) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-deadline:
+		server.Stop()
+	}
+}
+
+func (s *service) Wait() error {
+	<-s.done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *service) Done() <-chan struct{} {
+	return s.done
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	tlsDirName   = "tls"
+	certFileName = "cert.pem"
+	keyFileName  = "key.pem"
+
+	selfSignedValidity = 365 * 24 * time.Hour
+	certReloadInterval = 10 * time.Second
+)
+
+// serverName returns the name the server certificate is issued for and
+// verified against. Wildcard listening addresses are reached via localhost.
+func serverName() string {
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		return "localhost"
+	}
+	return host
+}
+
+// isLoopback reports whether the listening address is only reachable locally.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// certFiles returns the certificate and key files of the API service. Unless
+// both are configured, a self-signed pair is kept in the data directory.
+func certFiles(dataDir string) (string, string, error) {
+	switch {
+	case tlsCert != "" && tlsKey != "":
+		return tlsCert, tlsKey, nil
+	case tlsCert != "" || tlsKey != "":
+		return "", "", errors.New("--tls-cert and --tls-key must be set together")
+	}
+
+	dir := filepath.Join(dataDir, tlsDirName)
+	certFile, keyFile := filepath.Join(dir, certFileName), filepath.Join(dir, keyFileName)
+	if err := ensureSelfSigned(certFile, keyFile, serverName()); err != nil {
+		return "", "", fmt.Errorf("failed to generate self-signed certificate: %v", err)
+	}
+	return certFile, keyFile, nil
+}
+
+// ensureSelfSigned generates a self-signed certificate for name, unless the
+// existing one is still valid for it.
+func ensureSelfSigned(certFile, keyFile, name string) error {
+	if pair, err := tls.LoadX509KeyPair(certFile, keyFile); err == nil {
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err == nil && cert.VerifyHostname(name) == nil && time.Now().Before(cert.NotAfter) {
+			return nil
+		}
+	}
+	glog.Infof("Generating self-signed certificate for %s in %s", name, filepath.Dir(certFile))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"Ethermis"},
+			CommonName:   name,
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	seen := make(map[string]bool)
+	for _, h := range []string{"localhost", "127.0.0.1", "::1", name} {
+		if seen[h] {
+			continue
+		}
+		seen[h] = true
+
+		if ip := net.ParseIP(h); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, h)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(certFile), 0700); err != nil {
+		return err
+	}
+	if err := writePEM(keyFile, "EC PRIVATE KEY", keyDER, 0600); err != nil {
+		return err
+	}
+	return writePEM(certFile, "CERTIFICATE", der, 0644)
+}
+
+func writePEM(file, blockType string, der []byte, perm os.FileMode) error {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	return ioutil.WriteFile(file, data, perm)
+}
+
+// loadCertPool reads the PEM encoded certificates of file into a pool.
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+	return pool, nil
+}
+
+// dialOption returns the transport security for connecting to the API
+// service. Without a configured CA, the server certificate itself is trusted,
+// which is what makes self-signed certificates work.
+func dialOption(dataDir string) (grpc.DialOption, error) {
+	if (clientCert == "") != (clientKey == "") {
+		return nil, errors.New("client certificate and key must be given together")
+	}
+	if plaintext || grpcSocket != "" {
+		if clientCert != "" {
+			return nil, errors.New("client certificates require TLS")
+		}
+		return grpc.WithInsecure(), nil
+	}
+
+	caFile := tlsCA
+	if caFile == "" {
+		if caFile = tlsCert; caFile == "" {
+			caFile = filepath.Join(dataDir, tlsDirName, certFileName)
+		}
+	}
+	pool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA certificate: %v", err)
+	}
+
+	config := &tls.Config{
+		ServerName: serverName(),
+		RootCAs:    pool,
+	}
+	if clientCert != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// ----------------------------------------------------------------------------
+
+// certReloader serves a certificate pair from disk, reloading it whenever
+// either file changes.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *certReloader) reload() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.cert, r.modTime = &pair, modTime
+	r.mu.Unlock()
+	return nil
+}
+
+// lastModified returns the latest modification time of the pair.
+func (r *certReloader) lastModified() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// watch polls the pair for changes until quit is closed. A pair that fails
+// to load, e.g. while only one of the files has been replaced yet, keeps the
+// current certificate in place.
+func (r *certReloader) watch(quit chan struct{}) {
+	ticker := time.NewTicker(certReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+
+		modTime, err := r.lastModified()
+		r.mu.RLock()
+		changed := err == nil && modTime.After(r.modTime)
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+
+		if err := r.reload(); err != nil {
+			glog.Warningf("Failed to reload TLS certificate: %v", err)
+			continue
+		}
+		glog.Infof("Reloaded TLS certificate from %s", r.certFile)
+	}
+}
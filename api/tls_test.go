@@ -0,0 +1,97 @@
+package api
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnsureSelfSigned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, certFileName), filepath.Join(dir, keyFileName)
+
+	if err := ensureSelfSigned(certFile, keyFile, "localhost"); err != nil {
+		t.Fatal(err)
+	}
+	first, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadCertPool(certFile); err != nil {
+		t.Fatalf("certificate not usable as CA: %v", err)
+	}
+
+	// A valid certificate is kept, one for another name is replaced
+	if err := ensureSelfSigned(certFile, keyFile, "localhost"); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := ioutil.ReadFile(certFile); !bytes.Equal(again, first) {
+		t.Fatal("valid certificate regenerated")
+	}
+	if err := ensureSelfSigned(certFile, keyFile, "example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := ioutil.ReadFile(certFile); bytes.Equal(again, first) {
+		t.Fatal("certificate for another name kept")
+	}
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, certFileName), filepath.Join(dir, keyFileName)
+
+	if err := ensureSelfSigned(certFile, keyFile, "localhost"); err != nil {
+		t.Fatal(err)
+	}
+	r, err := newCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := func() string {
+		cert, err := r.GetCertificate(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return leaf.Subject.CommonName
+	}
+	if name := served(); name != "localhost" {
+		t.Fatalf("served certificate mismatch: have %s, want localhost", name)
+	}
+
+	// Replaced pairs are picked up
+	if err := ensureSelfSigned(certFile, keyFile, "example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if name := served(); name != "example.org" {
+		t.Fatalf("served certificate mismatch: have %s, want example.org", name)
+	}
+
+	// Broken pairs keep the current certificate in place
+	if err := ioutil.WriteFile(keyFile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.reload(); err == nil {
+		t.Fatal("broken key pair loaded")
+	}
+	if name := served(); name != "example.org" {
+		t.Fatalf("served certificate mismatch: have %s, want example.org", name)
+	}
+}
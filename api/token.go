@@ -0,0 +1,288 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	gw "github.com/alanchchen/ethermis/api/ethereum"
+)
+
+const (
+	tokenFileName      = "tokens.json"
+	adminTokenFileName = "admin.token"
+)
+
+// tokenRecord is a bearer token as stored in the token file. Only the hash of
+// the token itself is kept.
+type tokenRecord struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"` // Hex encoded SHA-256 of the token
+	Role      string    `json:"role"`
+	Accounts  []string  `json:"accounts,omitempty"`
+	Contracts []string  `json:"contracts,omitempty"`
+	Created   time.Time `json:"created"`
+}
+
+// tokenStore keeps the bearer tokens in a JSON file.
+type tokenStore struct {
+	file string
+
+	mu     sync.RWMutex
+	tokens []*tokenRecord
+}
+
+// openTokenStore loads the tokens of file. If there are none yet, an admin
+// token is created and written next to it, so that more can be issued.
+func openTokenStore(file string) (*tokenStore, error) {
+	s := &tokenStore{file: file}
+
+	data, err := ioutil.ReadFile(file)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &s.tokens); err != nil {
+			return nil, fmt.Errorf("invalid token file %s: %v", file, err)
+		}
+	}
+	if len(s.tokens) > 0 {
+		return s, nil
+	}
+
+	token, _, err := s.create("admin", roleAdmin, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	adminFile := filepath.Join(filepath.Dir(file), adminTokenFileName)
+	if err := ioutil.WriteFile(adminFile, []byte(token+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	glog.Warningf("Created admin API token in %s", adminFile)
+	return s, nil
+}
+
+// create issues a new token. The token is returned along with its record.
+func (s *tokenStore) create(name string, r role, accounts, contracts []string) (string, *tokenRecord, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, err
+	}
+	token := hex.EncodeToString(secret)
+
+	record := &tokenRecord{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		Hash:      hashToken(token),
+		Role:      r.String(),
+		Accounts:  accounts,
+		Contracts: contracts,
+		Created:   time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens = append(s.tokens, record)
+	if err := s.save(); err != nil {
+		s.tokens = s.tokens[:len(s.tokens)-1]
+		return "", nil, err
+	}
+	return token, record, nil
+}
+
+// revoke removes the token with the given id.
+func (s *tokenStore) revoke(id string) (*tokenRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, record := range s.tokens {
+		if record.ID != id {
+			continue
+		}
+		tokens := append(append([]*tokenRecord{}, s.tokens[:i]...), s.tokens[i+1:]...)
+		tokens, s.tokens = s.tokens, tokens
+		if err := s.save(); err != nil {
+			s.tokens = tokens
+			return nil, err
+		}
+		return record, nil
+	}
+	return nil, nil
+}
+
+// list returns all tokens by creation time.
+func (s *tokenStore) list() []*tokenRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := append([]*tokenRecord{}, s.tokens...)
+	sort.Sort(recordsByCreation(records))
+	return records
+}
+
+// lookup returns the record of a token, or nil if it is unknown.
+func (s *tokenStore) lookup(token string) *tokenRecord {
+	hash := []byte(hashToken(token))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, record := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(record.Hash), hash) == 1 {
+			return record
+		}
+	}
+	return nil
+}
+
+// save writes the tokens to a temporary file first, so that a failed write
+// leaves the previous ones intact. The caller must hold the lock.
+func (s *tokenStore) save() error {
+	data, err := json.MarshalIndent(s.tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.file), 0700); err != nil {
+		return err
+	}
+	tmp := s.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+type recordsByCreation []*tokenRecord
+
+func (r recordsByCreation) Len() int           { return len(r) }
+func (r recordsByCreation) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r recordsByCreation) Less(i, j int) bool { return r[i].Created.Before(r[j].Created) }
+
+// ----------------------------------------------------------------------------
+
+// tokenService implements the token administration RPCs.
+type tokenService struct {
+	store     *tokenStore
+	contracts contractResolver
+}
+
+func (t *tokenService) CreateToken(ctx context.Context, req *gw.CreateTokenRequest) (*gw.Token, error) {
+	r, err := parseRole(req.Role)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var accounts, contracts []string
+	for _, account := range req.Accounts {
+		if !common.IsHexAddress(account) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid account %q", account)
+		}
+		accounts = append(accounts, normalizeAddress(account))
+	}
+	// Pinned to addresses, so that re-pointing a name does not widen the scope
+	for _, contract := range req.Contracts {
+		address, err := resolveContract(ctx, t.contracts, contract)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "unknown contract %q: %v", contract, grpc.ErrorDesc(err))
+		}
+		contracts = append(contracts, address)
+	}
+
+	token, record, err := t.store.create(req.Name, r, accounts, contracts)
+	if err != nil {
+		return nil, err
+	}
+	return &gw.Token{
+		Info:  newTokenInfo(record),
+		Token: token,
+	}, nil
+}
+
+func (t *tokenService) RevokeToken(ctx context.Context, req *gw.RevokeTokenRequest) (*gw.TokenInfo, error) {
+	record, err := t.store.revoke(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, grpc.Errorf(codes.NotFound, "token %q not found", req.Id)
+	}
+	return newTokenInfo(record), nil
+}
+
+func (t *tokenService) ListTokens(ctx context.Context, req *gw.ListTokensRequest) (*gw.TokenList, error) {
+	list := new(gw.TokenList)
+	for _, record := range t.store.list() {
+		list.Tokens = append(list.Tokens, newTokenInfo(record))
+	}
+	return list, nil
+}
+
+func newTokenInfo(record *tokenRecord) *gw.TokenInfo {
+	return &gw.TokenInfo{
+		Id:        record.ID,
+		Name:      record.Name,
+		Role:      record.Role,
+		Accounts:  record.Accounts,
+		Contracts: record.Contracts,
+		Created:   record.Created.Unix(),
+	}
+}
+
+// normalizeAddress returns the checksummed form of hex addresses, so they
+// compare equal however they are written.
+func normalizeAddress(s string) string {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s).Hex()
+	}
+	return s
+}
+
+// tokenCredentials attaches a bearer token to the RPCs of a client.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows tokens without TLS, which the service only
+// serves on loopback addresses and its unix socket.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// bearerToken extracts the token of an authorization header value.
+func bearerToken(authorization string) (string, bool) {
+	const prefix = "bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(authorization[len(prefix):]), true
+}
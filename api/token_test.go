@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	gw "github.com/alanchchen/ethermis/api/ethereum"
+)
+
+func TestTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, tokenFileName)
+
+	// A fresh store issues an admin token to bootstrap from
+	store, err := openTokenStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, adminTokenFileName))
+	if err != nil {
+		t.Fatalf("admin token not written: %v", err)
+	}
+	admin := strings.TrimSpace(string(data))
+	if record := store.lookup(admin); record == nil || record.Role != "admin" {
+		t.Fatalf("admin token mismatch: have %+v", record)
+	}
+
+	token, record, err := store.create("bot", roleTransact, []string{addrA}, []string{addrC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := store.lookup(token); have != record {
+		t.Fatalf("lookup mismatch: have %+v, want %+v", have, record)
+	}
+	if store.lookup("invalid") != nil {
+		t.Fatal("unknown token found")
+	}
+
+	// Only hashes are written, and reopening keeps the tokens
+	data, err = ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte(token)) || bytes.Contains(data, []byte(admin)) {
+		t.Fatal("token file contains plain tokens")
+	}
+	reopened, err := openTokenStore(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have := reopened.list(); len(have) != 2 || have[0].Name != "admin" || !reflect.DeepEqual(have[1].Contracts, []string{addrC}) {
+		t.Fatalf("reopened tokens mismatch: have %+v", have)
+	}
+
+	// Revoked tokens stop working, also after reopening
+	if revoked, err := store.revoke(record.ID); err != nil || revoked != record {
+		t.Fatalf("revoke mismatch: have %+v, %v", revoked, err)
+	}
+	if revoked, err := store.revoke(record.ID); err != nil || revoked != nil {
+		t.Fatalf("revoked twice: have %+v, %v", revoked, err)
+	}
+	if store.lookup(token) != nil {
+		t.Fatal("revoked token found")
+	}
+	if reopened, err = openTokenStore(file); err != nil {
+		t.Fatal(err)
+	}
+	if reopened.lookup(token) != nil || reopened.lookup(admin) == nil {
+		t.Fatal("revocation not persisted")
+	}
+}
+
+func TestCreateToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ethermis-tokens")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := openTokenStore(filepath.Join(dir, tokenFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &tokenService{store: store, contracts: fakeRegistry{"Token": addrC}}
+
+	tests := []struct {
+		req       *gw.CreateTokenRequest
+		code      codes.Code
+		accounts  []string
+		contracts []string
+	}{
+		{
+			req:       &gw.CreateTokenRequest{Role: "transact", Accounts: []string{strings.ToLower(addrA)}, Contracts: []string{"Token", strings.ToLower(addrD)}},
+			code:      codes.OK,
+			accounts:  []string{normalizeAddress(addrA)},
+			contracts: []string{normalizeAddress(addrC), normalizeAddress(addrD)},
+		},
+		{req: &gw.CreateTokenRequest{Role: "root"}, code: codes.InvalidArgument},
+		{req: &gw.CreateTokenRequest{Role: "transact", Accounts: []string{"Token"}}, code: codes.InvalidArgument},
+		{req: &gw.CreateTokenRequest{Role: "transact", Contracts: []string{"Missing"}}, code: codes.InvalidArgument},
+		{req: &gw.CreateTokenRequest{Role: "transact", Contracts: []string{""}}, code: codes.InvalidArgument},
+	}
+	for i, tt := range tests {
+		token, err := service.CreateToken(context.Background(), tt.req)
+		if code := grpc.Code(err); code != tt.code {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.code)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(token.Info.Accounts, tt.accounts) || !reflect.DeepEqual(token.Info.Contracts, tt.contracts) {
+			t.Errorf("test %d: scope mismatch: have %v %v, want %v %v", i, token.Info.Accounts, token.Info.Contracts, tt.accounts, tt.contracts)
+		}
+	}
+}
@@ -34,6 +34,7 @@ to quickly create a Cobra application.`,
 
 func init() {
 	RootCmd.AddCommand(clientCmd)
+	clientCmd.Flags().AddFlagSet(api.ClientFlags)
 
 	// Here you will define your flags and configuration settings.
 
@@ -18,13 +18,17 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/alanchchen/ethermis/api"
+	"github.com/alanchchen/ethermis/constant"
 	"github.com/alanchchen/ethermis/ethereum"
 )
 
@@ -44,27 +48,64 @@ to quickly create a Cobra application.`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	Run: func(cmd *cobra.Command, args []string) {
-		// stack = ethereum.MakeFullNode(
-		// 	uint(constant.VersionMajor<<16|constant.VersionMinor<<8|constant.VersionPatch),
-		// 	constant.ClientIdentifier,
-		// 	constant.GitCommit,
-		// )
+		if ethereum.UseEmbeddedNode() {
+			stack = ethereum.MakeFullNode(
+				uint(constant.VersionMajor<<16|constant.VersionMinor<<8|constant.VersionPatch),
+				constant.ClientIdentifier,
+				constant.GitCommit,
+			)
+			if err := ethereum.StartNode(stack); err != nil {
+				cmd.Printf("failed to start node: %v\n", err)
+				return
+			}
+
+			// Deferred, so the node goes down only after the API service
+			defer func() {
+				if err := stack.Stop(); err != nil {
+					cmd.Printf("failed to stop node: %v\n", err)
+				}
+			}()
+		}
 
-		//utils.StartNode(stack)
+		controller, err := ethereum.NewController(stack)
+		if err != nil {
+			cmd.Printf("failed to initialize controller: %v\n", err)
+			return
+		}
+		// Deferred, so the controller is closed once no requests are
+		// served, and before the node goes down
+		if closer, ok := controller.(io.Closer); ok {
+			defer func() {
+				if err := closer.Close(); err != nil {
+					cmd.Printf("failed to close controller: %v\n", err)
+				}
+			}()
+		}
 
 		// Add the API service
-		apiService := api.New(
-			// api.UseController(
-			ethereum.NewController(),
-		// ),
-		)
-		if apiService == nil {
-			cmd.Println("failed to initialize API service")
+		apiService, err := api.New(controller, ethereum.MakeDataDir())
+		if err != nil {
+			cmd.Printf("failed to initialize API service: %v\n", err)
+			return
+		}
+		if err := apiService.Start(); err != nil {
+			cmd.Printf("failed to start API service: %v\n", err)
 			return
 		}
-		apiService.Start()
-		//stack.Wait()
-		apiService.Stop()
+
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+
+		select {
+		case sig := <-sigc:
+			cmd.Printf("Got %v, shutting down...\n", sig)
+			apiService.Stop()
+		case <-apiService.Done():
+		}
+		if err := apiService.Wait(); err != nil {
+			cmd.Printf("API service failed: %v\n", err)
+		}
 	},
 }
 
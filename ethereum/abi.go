@@ -0,0 +1,395 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// Bounds of the integers that fit the native Go types of the abi package.
+var (
+	minInt64 = big.NewInt(math.MinInt64)
+	maxInt64 = big.NewInt(math.MaxInt64)
+)
+
+// convertArgs decodes the JSON encoded arguments and converts them into the
+// Go types expected by the given ABI arguments.
+func convertArgs(inputs []abi.Argument, args []string) ([]interface{}, error) {
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("argument count mismatch: got %d, want %d", len(args), len(inputs))
+	}
+
+	values := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		v, err := convertArg(input.Type, decodeJSONArg(args[i]))
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%v %s): %v", i, input.Type, input.Name, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// decodeJSONArg decodes a single JSON encoded argument. Anything that is not
+// valid JSON is taken as a literal string, so that plain addresses and numbers
+// need not be quoted.
+func decodeJSONArg(arg string) interface{} {
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(arg))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return arg
+	}
+	return v
+}
+
+// convertArg converts a decoded JSON value into the Go type the abi package
+// packs for t.
+func convertArg(t abi.Type, v interface{}) (interface{}, error) {
+	// Byte strings are slices and arrays of uint8 too, but given in hex
+	switch t.T {
+	case abi.BytesTy:
+		return toBytes(v)
+
+	case abi.FixedBytesTy:
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > t.SliceSize {
+			return nil, fmt.Errorf("expected at most %d bytes, got %d", t.SliceSize, len(b))
+		}
+		rv := reflect.New(goType(t)).Elem()
+		reflect.Copy(rv, reflect.ValueOf(b))
+		return rv.Interface(), nil
+	}
+
+	if t.IsSlice || t.IsArray {
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected JSON array, got %T", v)
+		}
+		if t.IsArray && len(list) != t.SliceSize {
+			return nil, fmt.Errorf("expected %d elements, got %d", t.SliceSize, len(list))
+		}
+		elems := make([]reflect.Value, len(list))
+		for i, elem := range list {
+			ev, err := convertArg(*t.Elem, elem)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			elems[i] = reflect.ValueOf(ev)
+		}
+		elemType := goType(*t.Elem)
+		if len(elems) > 0 {
+			elemType = elems[0].Type()
+		}
+		slice := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(elems))
+		return reflect.Append(slice, elems...).Interface(), nil
+	}
+
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := toBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		if t.T == abi.UintTy && n.Sign() < 0 {
+			return nil, fmt.Errorf("negative value %v for unsigned type", n)
+		}
+		if t.Kind == reflect.Ptr {
+			return n, nil
+		}
+		rv := reflect.New(goType(t)).Elem()
+		if t.T == abi.UintTy {
+			if n.BitLen() > 64 || rv.OverflowUint(n.Uint64()) {
+				return nil, fmt.Errorf("value %v overflows %v", n, t)
+			}
+			rv.SetUint(n.Uint64())
+		} else {
+			if n.Cmp(minInt64) < 0 || n.Cmp(maxInt64) > 0 || rv.OverflowInt(n.Int64()) {
+				return nil, fmt.Errorf("value %v overflows %v", n, t)
+			}
+			rv.SetInt(n.Int64())
+		}
+		return rv.Interface(), nil
+
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected boolean, got %T", v)
+		}
+		return b, nil
+
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", v)
+		}
+		return s, nil
+
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return nil, fmt.Errorf("invalid address %v", v)
+		}
+		return common.HexToAddress(s), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %v", t)
+}
+
+// goType returns the Go type convertArg converts values of t into. Sized
+// integers are only told apart by their kind, the type being big.Int.
+func goType(t abi.Type) reflect.Type {
+	switch t.T {
+	case abi.BytesTy:
+		return reflect.TypeOf([]byte(nil))
+	case abi.FixedBytesTy:
+		return reflect.ArrayOf(t.SliceSize, reflect.TypeOf(byte(0)))
+	}
+	if t.IsSlice || t.IsArray {
+		return reflect.SliceOf(goType(*t.Elem))
+	}
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if typ, ok := intTypes[t.Kind]; ok {
+			return typ
+		}
+		return reflect.TypeOf((*big.Int)(nil))
+	case abi.BoolTy:
+		return reflect.TypeOf(false)
+	case abi.StringTy:
+		return reflect.TypeOf("")
+	}
+	return t.Type
+}
+
+var intTypes = map[reflect.Kind]reflect.Type{
+	reflect.Int8:   reflect.TypeOf(int8(0)),
+	reflect.Int16:  reflect.TypeOf(int16(0)),
+	reflect.Int32:  reflect.TypeOf(int32(0)),
+	reflect.Int64:  reflect.TypeOf(int64(0)),
+	reflect.Uint8:  reflect.TypeOf(uint8(0)),
+	reflect.Uint16: reflect.TypeOf(uint16(0)),
+	reflect.Uint32: reflect.TypeOf(uint32(0)),
+	reflect.Uint64: reflect.TypeOf(uint64(0)),
+}
+
+// toBigInt accepts JSON numbers as well as decimal or 0x-prefixed hex strings.
+func toBigInt(v interface{}) (*big.Int, error) {
+	var s string
+	switch v := v.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", v)
+	}
+
+	n, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		n, ok = n.SetString(s[2:], 16)
+	} else {
+		n, ok = n.SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+// toBytes decodes a hex string, with or without the 0x prefix.
+func toBytes(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected hex string, got %T", v)
+	}
+	return decodeHex(s)
+}
+
+// decodeHex is like common.FromHex but reports malformed input instead of
+// silently dropping it.
+func decodeHex(s string) ([]byte, error) {
+	h := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(h)%2 == 1 {
+		return nil, fmt.Errorf("odd length hex string %q", s)
+	}
+	if !isHex(h) {
+		return nil, fmt.Errorf("invalid hex string %q", s)
+	}
+	return common.Hex2Bytes(h), nil
+}
+
+func isHex(s string) bool {
+	for _, c := range []byte(s) {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// toValues converts unpacked ABI outputs into their JSON representation.
+func toValues(outputs []abi.Argument, values []interface{}) ([]*ethereum.Value, error) {
+	result := make([]*ethereum.Value, len(values))
+	for i, v := range values {
+		data, err := json.Marshal(toJSONValue(v))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &ethereum.Value{
+			Name: outputs[i].Name,
+			Type: outputs[i].Type.String(),
+			Json: string(data),
+		}
+	}
+	return result, nil
+}
+
+// toJSONValue converts a value produced by the abi package into something
+// encoding/json renders losslessly: integers wider than 64 bits become decimal
+// strings and byte sequences become 0x-prefixed hex.
+func toJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return common.ToHex(v)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return common.ToHex(b)
+		}
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = toJSONValue(rv.Index(i).Interface())
+		}
+		return list
+	}
+	return v
+}
+
+// unpackArgs decodes ABI encoded data according to the given arguments. The
+// abi package only unpacks method outputs, so the arguments are wrapped in an
+// anonymous method.
+func unpackArgs(args []abi.Argument, data []byte) ([]interface{}, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	wrapper := abi.ABI{
+		Methods: map[string]abi.Method{"": {Outputs: args}},
+	}
+	if len(args) == 1 {
+		var value interface{}
+		if err := wrapper.Unpack(&value, "", data); err != nil {
+			return nil, err
+		}
+		return []interface{}{value}, nil
+	}
+
+	var values []interface{}
+	if err := wrapper.Unpack(&values, "", data); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// decodeLog looks up the event matching the log's first topic and decodes its
+// indexed and non-indexed fields. Indexed fields of dynamic types are only
+// available as their Keccak256 hash and are returned as such.
+func decodeLog(parsedABI *abi.ABI, log *types.Log) (string, []*ethereum.Value, error) {
+	if len(log.Topics) == 0 {
+		return "", nil, nil
+	}
+
+	var event *abi.Event
+	for _, e := range parsedABI.Events {
+		if !e.Anonymous && e.Id() == log.Topics[0] {
+			e := e
+			event = &e
+			break
+		}
+	}
+	if event == nil {
+		return "", nil, nil
+	}
+
+	var indexed, plain []abi.Argument
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		} else {
+			plain = append(plain, input)
+		}
+	}
+	if len(indexed) != len(log.Topics)-1 {
+		return "", nil, fmt.Errorf("event %s: expected %d topics, got %d", event.Name, len(indexed)+1, len(log.Topics))
+	}
+
+	plainValues, err := unpackArgs(plain, log.Data)
+	if err != nil {
+		return "", nil, fmt.Errorf("event %s: %v", event.Name, err)
+	}
+
+	values := make([]interface{}, 0, len(event.Inputs))
+	for i, topic := range log.Topics[1:] {
+		if isDynamicType(indexed[i].Type) {
+			values = append(values, topic)
+			continue
+		}
+		v, err := unpackArgs(indexed[i:i+1], topic.Bytes())
+		if err != nil {
+			return "", nil, fmt.Errorf("event %s: %v", event.Name, err)
+		}
+		values = append(values, v[0])
+	}
+	values = append(values, plainValues...)
+
+	fields, err := toValues(append(indexed, plain...), values)
+	if err != nil {
+		return "", nil, err
+	}
+	return event.Name, fields, nil
+}
+
+// isDynamicType reports whether t is encoded as a dynamic ABI type.
+func isDynamicType(t abi.Type) bool {
+	return t.IsSlice || t.IsArray || t.T == abi.StringTy || t.T == abi.BytesTy
+}
@@ -0,0 +1,118 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestConvertArg(t *testing.T) {
+	bigInt := func(s string) *big.Int {
+		n, _ := new(big.Int).SetString(s, 10)
+		return n
+	}
+	tests := []struct {
+		typ  string
+		arg  interface{}
+		want interface{} // Nil if the conversion must fail
+	}{
+		{"int8", json.Number("-128"), int8(-128)},
+		{"int8", json.Number("128"), nil},
+		{"uint8", json.Number("255"), uint8(255)},
+		{"uint8", json.Number("256"), nil},
+		{"uint8", json.Number("-1"), nil},
+		{"int64", json.Number("-9223372036854775808"), int64(-9223372036854775808)},
+		{"int64", json.Number("9223372036854775807"), int64(9223372036854775807)},
+		{"int64", json.Number("-9223372036854775809"), nil},
+		{"int64", json.Number("9223372036854775808"), nil},
+		{"uint64", json.Number("18446744073709551615"), uint64(18446744073709551615)},
+		{"uint64", json.Number("18446744073709551616"), nil},
+		{"uint256", "0x10000000000000000", bigInt("18446744073709551616")},
+		{"int256", json.Number("-18446744073709551616"), bigInt("-18446744073709551616")},
+		{"uint256", json.Number("-1"), nil},
+		{"uint256", "1.5", nil},
+		{"uint256", true, nil},
+		{"bool", true, true},
+		{"bool", "true", nil},
+		{"string", "hello", "hello"},
+		{"address", "0x00000000000000000000000000000000000000aa", common.HexToAddress("0xaa")},
+		{"address", "0xaa", nil},
+		{"bytes", "0x0102", []byte{1, 2}},
+		{"bytes", "0x012", nil},
+		{"bytes2", "0x01", [2]byte{1, 0}},
+		{"bytes2", "0x010203", nil},
+		{"uint8[2]", []interface{}{json.Number("1"), json.Number("2")}, []uint8{1, 2}},
+		{"uint8[2]", []interface{}{json.Number("1")}, nil},
+		{"uint8[]", []interface{}{json.Number("1"), json.Number("256")}, nil},
+		{"address[]", []interface{}{}, []common.Address{}},
+		{"uint8[]", "0x01", nil},
+	}
+	for i, tt := range tests {
+		typ, err := abi.NewType(tt.typ)
+		if err != nil {
+			t.Fatalf("test %d: invalid type %s: %v", i, tt.typ, err)
+		}
+		have, err := convertArg(typ, tt.arg)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("test %d: %s %v: expected error, got %v", i, tt.typ, tt.arg, have)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: %s %v: unexpected error: %v", i, tt.typ, tt.arg, err)
+			continue
+		}
+		if !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("test %d: %s %v: value mismatch: have %#v, want %#v", i, tt.typ, tt.arg, have, tt.want)
+		}
+	}
+}
+
+func TestConvertArgsPack(t *testing.T) {
+	const definition = `[{"type": "function", "name": "f", "inputs": [
+		{"name": "a", "type": "int8"},
+		{"name": "b", "type": "uint64"},
+		{"name": "c", "type": "uint256"},
+		{"name": "d", "type": "bytes2"},
+		{"name": "e", "type": "bytes"},
+		{"name": "f", "type": "uint8[2]"},
+		{"name": "g", "type": "address[]"},
+		{"name": "h", "type": "bool"},
+		{"name": "i", "type": "string"}
+	]}]`
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := []string{`-1`, `"18446744073709551615"`, `"0x10"`, `"0x0102"`, `"0x"`, `[1, 2]`, `[]`, `true`, `"hi"`}
+
+	values, err := convertArgs(parsed.Methods["f"].Inputs, args)
+	if err != nil {
+		t.Fatalf("conversion failed: %v", err)
+	}
+	if _, err := parsed.Pack("f", values...); err != nil {
+		t.Fatalf("packing failed: %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// Bounds of unlocking an account over the API, which must not leave it
+// unlocked indefinitely
+const (
+	defaultUnlockDuration = 5 * time.Minute
+	maxUnlockDuration     = time.Hour
+)
+
+func (c *ethereumController) NewAccount(ctx context.Context, req *ethereum.NewAccountRequest) (*ethereum.Account, error) {
+	account, err := c.accman.NewAccount(req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return c.account(ctx, account.Address)
+}
+
+func (c *ethereumController) ListAccounts(ctx context.Context, req *ethereum.ListAccountsRequest) (*ethereum.AccountList, error) {
+	list := new(ethereum.AccountList)
+	for _, account := range c.accman.Accounts() {
+		a, err := c.account(ctx, account.Address)
+		if err != nil {
+			return nil, err
+		}
+		list.Accounts = append(list.Accounts, a)
+	}
+	return list, nil
+}
+
+func (c *ethereumController) ImportAccount(ctx context.Context, req *ethereum.ImportAccountRequest) (*ethereum.Account, error) {
+	var (
+		account accounts.Account
+		err     error
+	)
+
+	switch key := req.Key.(type) {
+	case *ethereum.ImportAccountRequest_PrivateKey:
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(key.PrivateKey, "0x"))
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid private key: %v", err)
+		}
+		if account, err = c.accman.ImportECDSA(privateKey, req.Passphrase); err != nil {
+			return nil, accountError(err)
+		}
+
+	case *ethereum.ImportAccountRequest_Keyfile:
+		newPassphrase := req.NewPassphrase
+		if newPassphrase == "" {
+			newPassphrase = req.Passphrase
+		}
+		if account, err = c.accman.Import([]byte(key.Keyfile), req.Passphrase, newPassphrase); err != nil {
+			return nil, accountError(err)
+		}
+
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing private key or keyfile")
+	}
+
+	return c.account(ctx, account.Address)
+}
+
+func (c *ethereumController) ExportAccount(ctx context.Context, req *ethereum.ExportAccountRequest) (*ethereum.Keyfile, error) {
+	address, err := parseAccount(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	newPassphrase := req.NewPassphrase
+	if newPassphrase == "" {
+		newPassphrase = req.Passphrase
+	}
+	keyJSON, err := c.accman.Export(accounts.Account{Address: address}, req.Passphrase, newPassphrase)
+	if err != nil {
+		return nil, accountError(err)
+	}
+
+	return &ethereum.Keyfile{
+		Address: address.Hex(),
+		Keyfile: string(keyJSON),
+	}, nil
+}
+
+func (c *ethereumController) UnlockAccount(ctx context.Context, req *ethereum.UnlockAccountRequest) (*ethereum.Account, error) {
+	address, err := parseAccount(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(req.Duration) * time.Second
+	if duration == 0 {
+		duration = defaultUnlockDuration
+	}
+	if duration > maxUnlockDuration {
+		return nil, grpc.Errorf(codes.InvalidArgument, "cannot unlock for more than %v", maxUnlockDuration)
+	}
+	if err := c.accman.TimedUnlock(accounts.Account{Address: address}, req.Passphrase, duration); err != nil {
+		return nil, accountError(err)
+	}
+	return c.account(ctx, address)
+}
+
+func (c *ethereumController) LockAccount(ctx context.Context, req *ethereum.LockAccountRequest) (*ethereum.Account, error) {
+	address, err := parseAccount(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.accman.HasAddress(address) {
+		return nil, accountError(accounts.ErrNoMatch)
+	}
+	if err := c.accman.Lock(address); err != nil {
+		return nil, accountError(err)
+	}
+	return c.account(ctx, address)
+}
+
+// account returns an account along with its balance at the latest block.
+func (c *ethereumController) account(ctx context.Context, address common.Address) (*ethereum.Account, error) {
+	balance, err := c.backend.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ethereum.Account{
+		Address: address.Hex(),
+		Balance: balance.String(),
+	}, nil
+}
+
+// parseAccount validates the address of an account request.
+func parseAccount(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, grpc.Errorf(codes.InvalidArgument, "invalid account address %q", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+// accountError maps keystore errors to the matching status codes.
+func accountError(err error) error {
+	switch err {
+	case accounts.ErrNoMatch:
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	case accounts.ErrDecrypt:
+		return grpc.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+func TestUnlockAccountMaxDuration(t *testing.T) {
+	c := &ethereumController{}
+	req := &ethereum.UnlockAccountRequest{
+		Address:  "0x00000000000000000000000000000000000000aa",
+		Duration: uint32(maxUnlockDuration.Seconds()) + 1,
+	}
+	if _, err := c.UnlockAccount(context.Background(), req); grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error mismatch: have %v, want invalid argument", err)
+	}
+}
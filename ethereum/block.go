@@ -0,0 +1,86 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+func (c *ethereumController) GetBlock(ctx context.Context, req *ethereum.BlockRequest) (*ethereum.Block, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+
+	if data, herr := decodeHex(req.Block); herr == nil && len(data) == common.HashLength {
+		block, err = c.backend.BlockByHash(ctx, common.BytesToHash(data))
+	} else {
+		number, pending, perr := parseBlock(req.Block)
+		if perr != nil {
+			return nil, perr
+		}
+		if pending {
+			return nil, grpc.Errorf(codes.InvalidArgument, "pending block is not available")
+		}
+		block, err = c.backend.BlockByNumber(ctx, number)
+	}
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	return newBlock(block, req.FullTransactions), nil
+}
+
+func (c *ethereumController) GetChainHead(ctx context.Context, req *ethereum.ChainHeadRequest) (*ethereum.Block, error) {
+	block, err := c.backend.BlockByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newBlock(block, false), nil
+}
+
+// newBlock converts a block, with its full transactions if requested.
+func newBlock(block *types.Block, fullTransactions bool) *ethereum.Block {
+	b := &ethereum.Block{
+		Number:     block.NumberU64(),
+		Hash:       block.Hash().Hex(),
+		ParentHash: block.ParentHash().Hex(),
+		Miner:      block.Coinbase().Hex(),
+		Timestamp:  block.Time().Uint64(),
+		Difficulty: block.Difficulty().String(),
+		GasLimit:   block.GasLimit().Uint64(),
+		GasUsed:    block.GasUsed().Uint64(),
+		ExtraData:  common.ToHex(block.Extra()),
+		Size:       uint64(block.Size()),
+	}
+
+	for _, tx := range block.Transactions() {
+		b.TransactionIds = append(b.TransactionIds, tx.Hash().Hex())
+		if fullTransactions {
+			t := newTransaction(tx)
+			t.BlockNumber, t.BlockHash = b.Number, b.Hash
+			b.Transactions = append(b.Transactions, t)
+		}
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+var (
+	errEmptyBytecode    = errors.New("empty bytecode")
+	errAmbiguousCode    = errors.New("code and bytecode are mutually exclusive")
+	errUnlinkedBytecode = errors.New("bytecode contains unlinked library references")
+)
+
+// combinedOutput is the subset of solc --combined-json abi,bin output needed
+// for deployment.
+type combinedOutput struct {
+	Contracts map[string]struct {
+		Abi json.RawMessage `json:"abi"`
+		Bin string          `json:"bin"`
+	} `json:"contracts"`
+}
+
+// decodeContract returns the ABI definition and the EVM bytecode of the given
+// contract, accepting hex, raw bytes or solc combined JSON output.
+func decodeContract(contract *ethereum.CompiledContract) (string, []byte, error) {
+	var (
+		abiJSON = contract.Abi
+		code    []byte
+		err     error
+	)
+
+	switch {
+	case len(contract.Bytecode) > 0:
+		if contract.Code != "" {
+			return "", nil, errAmbiguousCode
+		}
+		code = contract.Bytecode
+
+	case strings.HasPrefix(strings.TrimSpace(contract.Code), "{"):
+		var combinedABI, bin string
+		if combinedABI, bin, err = parseCombinedJSON(contract.Code, contract.Name); err != nil {
+			return "", nil, err
+		}
+		if abiJSON == "" {
+			abiJSON = combinedABI
+		}
+		if code, err = decodeBytecode(bin); err != nil {
+			return "", nil, err
+		}
+
+	default:
+		if code, err = decodeBytecode(contract.Code); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if len(code) == 0 {
+		return "", nil, errEmptyBytecode
+	}
+	return abiJSON, code, nil
+}
+
+// decodeBytecode decodes hex encoded bytecode, with or without the 0x prefix.
+func decodeBytecode(code string) ([]byte, error) {
+	code = strings.TrimSpace(code)
+	if strings.Contains(code, "__") {
+		return nil, errUnlinkedBytecode
+	}
+	return decodeHex(code)
+}
+
+// parseCombinedJSON picks the named contract from solc combined JSON output.
+// The name may be omitted if the output holds a single contract.
+func parseCombinedJSON(data, name string) (string, string, error) {
+	var output combinedOutput
+	if err := json.Unmarshal([]byte(data), &output); err != nil {
+		return "", "", fmt.Errorf("invalid combined JSON: %v", err)
+	}
+
+	var keys []string
+	for key := range output.Contracts {
+		if name == "" || key == name || strings.HasSuffix(key, ":"+name) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	switch {
+	case len(keys) == 0 && name == "":
+		return "", "", errors.New("no contracts in combined JSON")
+	case len(keys) == 0:
+		return "", "", fmt.Errorf("contract %q not found in combined JSON", name)
+	case len(keys) > 1:
+		return "", "", fmt.Errorf("ambiguous contract, pick one of %s", strings.Join(keys, ", "))
+	}
+
+	contract := output.Contracts[keys[0]]
+
+	// Older solc versions emit the ABI as a JSON encoded string
+	abiJSON := string(contract.Abi)
+	if strings.HasPrefix(abiJSON, `"`) {
+		if err := json.Unmarshal(contract.Abi, &abiJSON); err != nil {
+			return "", "", fmt.Errorf("invalid ABI of %s: %v", keys[0], err)
+		}
+	}
+	return abiJSON, contract.Bin, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/net/context"
+)
+
+// Blockchain backends the controller can drive
+const (
+	backendSimulated = "simulated" // In-memory simulated blockchain
+	backendNode      = "node"      // Embedded full node
+	backendRPC       = "rpc"       // Remote node over JSON-RPC
+)
+
+// chainBackend is the blockchain the controller deploys to and transacts
+// with.
+type chainBackend interface {
+	bind.ContractBackend
+
+	// State of an account, at the latest block if blockNumber is nil
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+
+	// State of an account in the pending block
+	PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error)
+	PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error)
+
+	// TransactionReceipt returns the receipt of a mined transaction.
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+
+	// TransactionByHash returns a mined or pending transaction.
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error)
+
+	// TransactionBlock returns the hash and number of the block holding the
+	// given transaction, the hash being zero if it has not been mined yet.
+	TransactionBlock(ctx context.Context, txHash common.Hash) (common.Hash, uint64, error)
+
+	// BlockByNumber returns the block with the given number, or the latest
+	// block if number is nil.
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+
+	// BlockByHash returns the block with the given hash.
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+
+	// HeaderByNumber returns the header of the block with the given number,
+	// or of the latest block if number is nil.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+
+	// FilterLogs returns the logs of the mined blocks matching the query.
+	FilterLogs(ctx context.Context, query goethereum.FilterQuery) ([]types.Log, error)
+}
+
+// UseEmbeddedNode reports whether the controller is configured to run on top
+// of the embedded full node.
+func UseEmbeddedNode() bool {
+	return backendType == backendNode
+}
+
+// ----------------------------------------------------------------------------
+
+// This nil assignment ensures compile time that rpcBackend implements chainBackend.
+var _ chainBackend = (*rpcBackend)(nil)
+
+// rpcBackend talks to a go-ethereum node over its JSON-RPC API, either
+// remotely or attached in-process to the embedded node.
+type rpcBackend struct {
+	*ethclient.Client
+	client *rpc.Client
+}
+
+// dialRPCBackend connects to the node listening on the given endpoint.
+func dialRPCBackend(endpoint string) (*rpcBackend, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return newRPCBackend(client), nil
+}
+
+// attachRPCBackend attaches to the in-process RPC server of a running node.
+func attachRPCBackend(stack *node.Node) (*rpcBackend, error) {
+	if stack == nil {
+		return nil, errors.New("embedded node is not running")
+	}
+	client, err := stack.Attach()
+	if err != nil {
+		return nil, err
+	}
+	return newRPCBackend(client), nil
+}
+
+func newRPCBackend(client *rpc.Client) *rpcBackend {
+	return &rpcBackend{
+		Client: ethclient.NewClient(client),
+		client: client,
+	}
+}
+
+// rpcTransaction is the block information of a transaction returned by
+// eth_getTransactionByHash.
+type rpcTransaction struct {
+	BlockHash   *common.Hash `json:"blockHash"`
+	BlockNumber *string      `json:"blockNumber"`
+}
+
+// TransactionByHash returns the transaction with the given hash, reporting
+// unknown ones as not found.
+func (b *rpcBackend) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	var raw json.RawMessage
+	if err := b.client.CallContext(ctx, &raw, "eth_getTransactionByHash", txHash); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, goethereum.NotFound
+	}
+	tx := new(types.Transaction)
+	if err := json.Unmarshal(raw, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// TransactionBlock retrieves the block of a transaction, which the ethclient
+// transaction lookups do not expose.
+func (b *rpcBackend) TransactionBlock(ctx context.Context, txHash common.Hash) (common.Hash, uint64, error) {
+	var tx *rpcTransaction
+	if err := b.client.CallContext(ctx, &tx, "eth_getTransactionByHash", txHash); err != nil {
+		return common.Hash{}, 0, err
+	}
+	if tx == nil || tx.BlockHash == nil || tx.BlockNumber == nil {
+		return common.Hash{}, 0, nil
+	}
+	number, err := strconv.ParseUint(strings.TrimPrefix(*tx.BlockNumber, "0x"), 16, 64)
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+	return *tx.BlockHash, number, nil
+}
+
+// watchPendingTransactions calls fn with the hash of every transaction
+// entering the pool of the node, polling it through a filter until ctx is
+// done or fn fails.
+func (b *rpcBackend) watchPendingTransactions(ctx context.Context, fn func(common.Hash) error) error {
+	var id string
+	if err := b.client.CallContext(ctx, &id, "eth_newPendingTransactionFilter"); err != nil {
+		return err
+	}
+	defer b.client.CallContext(context.Background(), nil, "eth_uninstallFilter", id)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		var hashes []common.Hash
+		if err := b.client.CallContext(ctx, &hashes, "eth_getFilterChanges", id); err != nil {
+			return err
+		}
+		for _, hash := range hashes {
+			if err := fn(hash); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
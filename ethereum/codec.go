@@ -0,0 +1,151 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+func (c *ethereumController) EncodeCall(ctx context.Context, req *ethereum.EncodeCallRequest) (*ethereum.EncodedCall, error) {
+	parsedABI, err := parseABI(req.Abi)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, name := parsedABI.Constructor.Inputs, "constructor"
+	if req.Method != "" {
+		m, ok := parsedABI.Methods[req.Method]
+		if !ok {
+			return nil, grpc.Errorf(codes.InvalidArgument, "method %q not found in ABI", req.Method)
+		}
+		inputs, name = m.Inputs, "method "+req.Method
+	}
+
+	args, err := convertArgs(inputs, req.Args)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s %v", name, err)
+	}
+	data, err := parsedABI.Pack(req.Method, args...)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s: %v", name, err)
+	}
+
+	return &ethereum.EncodedCall{
+		Data: common.ToHex(data),
+	}, nil
+}
+
+func (c *ethereumController) DecodeOutput(ctx context.Context, req *ethereum.DecodeOutputRequest) (*ethereum.CallResult, error) {
+	parsedABI, err := parseABI(req.Abi)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := parsedABI.Methods[req.Method]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %q not found in ABI", req.Method)
+	}
+	data, err := decodeHex(req.Data)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid output data: %v", err)
+	}
+
+	outputs, err := unpackArgs(m.Outputs, data)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %s: %v", req.Method, err)
+	}
+	values, err := toValues(m.Outputs, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethereum.CallResult{
+		Outputs: values,
+	}, nil
+}
+
+func (c *ethereumController) DecodeInput(ctx context.Context, req *ethereum.DecodeInputRequest) (*ethereum.DecodedInput, error) {
+	parsedABI, err := parseABI(req.Abi)
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeHex(req.Data)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid input data: %v", err)
+	}
+
+	method, args, err := decodeInput(&parsedABI, data)
+	if err == errUnknownMethod {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	} else if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %s: %v", method, err)
+	}
+
+	return &ethereum.DecodedInput{
+		Method: method,
+		Args:   args,
+	}, nil
+}
+
+func (c *ethereumController) DecodeLog(ctx context.Context, req *ethereum.DecodeLogRequest) (*ethereum.DecodedLog, error) {
+	parsedABI, err := parseABI(req.Abi)
+	if err != nil {
+		return nil, err
+	}
+
+	log := new(types.Log)
+	for i, topic := range req.Topics {
+		b, err := decodeHex(topic)
+		if err != nil || len(b) != common.HashLength {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid topic %d %q", i, topic)
+		}
+		log.Topics = append(log.Topics, common.BytesToHash(b))
+	}
+	if log.Data, err = decodeHex(req.Data); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid log data: %v", err)
+	}
+
+	event, fields, err := decodeLog(&parsedABI, log)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if event == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "no event matches the log topics")
+	}
+
+	return &ethereum.DecodedLog{
+		Event:  event,
+		Fields: fields,
+	}, nil
+}
+
+// parseABI parses the JSON ABI definition of a contract.
+func parseABI(abiJSON string) (abi.ABI, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return abi.ABI{}, grpc.Errorf(codes.InvalidArgument, "invalid ABI: %v", err)
+	}
+	return parsedABI, nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+const defaultOptimizeRuns = 200
+
+// solcInput is the solc standard JSON input.
+type solcInput struct {
+	Language string                `json:"language"`
+	Sources  map[string]solcSource `json:"sources"`
+	Settings solcSettings          `json:"settings"`
+}
+
+type solcSource struct {
+	Content string `json:"content"`
+}
+
+type solcSettings struct {
+	Optimizer struct {
+		Enabled bool   `json:"enabled"`
+		Runs    uint32 `json:"runs"`
+	} `json:"optimizer"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+// solcOutput is the subset of the solc standard JSON output returned to
+// clients.
+type solcOutput struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+		SourceLocation   *struct {
+			File string `json:"file"`
+		} `json:"sourceLocation"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		Abi      json.RawMessage `json:"abi"`
+		Metadata string          `json:"metadata"`
+		Evm      struct {
+			Bytecode struct {
+				Object string `json:"object"`
+			} `json:"bytecode"`
+			DeployedBytecode struct {
+				Object string `json:"object"`
+			} `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+func (c *ethereumController) Compile(ctx context.Context, req *ethereum.CompileRequest) (*ethereum.CompileResult, error) {
+	return compileSources(ctx, req)
+}
+
+func (c *ethereumController) DeploySource(ctx context.Context, req *ethereum.DeploySourceRequest) (*ethereum.DeploymentInfo, error) {
+	if req.Compile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing sources")
+	}
+	result, err := compileSources(ctx, req.Compile)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := pickContract(result.Contracts, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return c.Deploy(ctx, &ethereum.CompiledContract{
+		Abi:     contract.Abi,
+		Code:    contract.Bytecode,
+		Args:    req.Args,
+		Name:    contract.Name,
+		Options: req.Options,
+		Version: req.Version,
+		Tags:    req.Tags,
+	})
+}
+
+// pickContract returns the compiled contract called name, or the only one
+// compiled if name is empty.
+func pickContract(contracts []*ethereum.ContractArtifact, name string) (*ethereum.ContractArtifact, error) {
+	var matches []*ethereum.ContractArtifact
+	for _, contract := range contracts {
+		if name == "" || matchContract(contract, name) {
+			matches = append(matches, contract)
+		}
+	}
+	switch {
+	case len(matches) == 0:
+		return nil, grpc.Errorf(codes.InvalidArgument, "contract %q not found in sources", name)
+	case len(matches) > 1:
+		var names []string
+		for _, contract := range matches {
+			names = append(names, contract.Source+":"+contract.Name)
+		}
+		return nil, grpc.Errorf(codes.InvalidArgument, "ambiguous contract, pick one of %s", strings.Join(names, ", "))
+	}
+	return matches[0], nil
+}
+
+// compileSources compiles Solidity sources with the solc binary given by
+// --solc through its standard JSON interface.
+func compileSources(ctx context.Context, req *ethereum.CompileRequest) (*ethereum.CompileResult, error) {
+	if len(req.Sources) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing sources")
+	}
+
+	input := solcInput{
+		Language: "Solidity",
+		Sources:  make(map[string]solcSource),
+	}
+	for i, source := range req.Sources {
+		if source.Name == "" {
+			return nil, grpc.Errorf(codes.InvalidArgument, "source %d has no name", i)
+		}
+		if _, ok := input.Sources[source.Name]; ok {
+			return nil, grpc.Errorf(codes.InvalidArgument, "duplicate source %q", source.Name)
+		}
+		input.Sources[source.Name] = solcSource{Content: source.Content}
+	}
+	input.Settings.Optimizer.Enabled = req.Optimize
+	input.Settings.Optimizer.Runs = req.OptimizeRuns
+	if input.Settings.Optimizer.Runs == 0 {
+		input.Settings.Optimizer.Runs = defaultOptimizeRuns
+	}
+	input.Settings.OutputSelection = map[string]map[string][]string{
+		"*": {"*": {"abi", "metadata", "evm.bytecode.object", "evm.deployedBytecode.object"}},
+	}
+
+	output, err := runSolc(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(ethereum.CompileResult)
+	var errs []string
+	warnings := make(map[string][]string) // Per source unit
+	for _, e := range output.Errors {
+		switch {
+		case e.Severity != "warning":
+			errs = append(errs, e.FormattedMessage)
+		case e.SourceLocation != nil && e.SourceLocation.File != "":
+			warnings[e.SourceLocation.File] = append(warnings[e.SourceLocation.File], e.FormattedMessage)
+		default:
+			result.Warnings = append(result.Warnings, e.FormattedMessage)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "compilation failed:\n%s", strings.Join(errs, "\n"))
+	}
+
+	unreported := make(map[string]bool)
+	for source := range warnings {
+		unreported[source] = true
+	}
+	for source, contracts := range output.Contracts {
+		for name, contract := range contracts {
+			artifact := &ethereum.ContractArtifact{
+				Source:          source,
+				Name:            name,
+				Abi:             string(contract.Abi),
+				Bytecode:        contract.Evm.Bytecode.Object,
+				RuntimeBytecode: contract.Evm.DeployedBytecode.Object,
+				Metadata:        contract.Metadata,
+				Warnings:        warnings[source],
+			}
+			if len(req.Contracts) > 0 && !matchAnyContract(artifact, req.Contracts) {
+				continue
+			}
+			result.Contracts = append(result.Contracts, artifact)
+			delete(unreported, source)
+		}
+	}
+	// Keep the warnings of source units without any contract returned
+	var sources []string
+	for source := range unreported {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		result.Warnings = append(result.Warnings, warnings[source]...)
+	}
+	sort.Sort(artifactsByName(result.Contracts))
+	return result, nil
+}
+
+// runSolc feeds standard JSON input to solc and parses its output.
+func runSolc(ctx context.Context, input *solcInput) (*solcOutput, error) {
+	path, err := exec.LookPath(solcPath)
+	if err != nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "solidity compiler not available: %v", err)
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, "--standard-json")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "solc: %v\n%s", err, stderr.String())
+	}
+
+	output := new(solcOutput)
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "invalid solc output: %v", err)
+	}
+	return output, nil
+}
+
+// matchContract reports whether an artifact is the contract called name,
+// optionally qualified by its source as "source:name".
+func matchContract(artifact *ethereum.ContractArtifact, name string) bool {
+	return name == artifact.Name || name == artifact.Source+":"+artifact.Name
+}
+
+func matchAnyContract(artifact *ethereum.ContractArtifact, names []string) bool {
+	for _, name := range names {
+		if matchContract(artifact, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// artifactsByName sorts compiled contracts by source and name.
+type artifactsByName []*ethereum.ContractArtifact
+
+func (a artifactsByName) Len() int      { return len(a) }
+func (a artifactsByName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a artifactsByName) Less(i, j int) bool {
+	if a[i].Source != a[j].Source {
+		return a[i].Source < a[j].Source
+	}
+	return a[i].Name < a[j].Name
+}
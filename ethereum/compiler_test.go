@@ -0,0 +1,172 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// fakeSolc points --solc at a script answering any standard JSON input with
+// output, and returns a function restoring the previous compiler.
+func fakeSolc(t *testing.T, output string) func() {
+	dir, err := ioutil.TempDir("", "ethermis-solc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "output.json"), []byte(output), 0644); err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\ncat >/dev/null\ncat \"$(dirname \"$0\")/output.json\"\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "solc"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	old := solcPath
+	solcPath = filepath.Join(dir, "solc")
+	return func() {
+		solcPath = old
+		os.RemoveAll(dir)
+	}
+}
+
+var compileRequest = &ethereum.CompileRequest{
+	Sources: []*ethereum.SourceFile{
+		{Name: "a.sol", Content: "contract A {}"},
+		{Name: "b.sol", Content: "contract B {} contract C {}"},
+	},
+}
+
+const compileOutput = `{
+	"errors": [
+		{"severity": "warning", "formattedMessage": "a.sol: unused variable", "sourceLocation": {"file": "a.sol"}},
+		{"severity": "warning", "formattedMessage": "b.sol: shadowed name", "sourceLocation": {"file": "b.sol"}},
+		{"severity": "warning", "formattedMessage": "experimental features"}
+	],
+	"contracts": {
+		"b.sol": {
+			"B": {"abi": [], "evm": {"bytecode": {"object": "6002"}, "deployedBytecode": {"object": "02"}}},
+			"C": {"abi": [], "evm": {"bytecode": {"object": "6003"}, "deployedBytecode": {"object": "03"}}}
+		},
+		"a.sol": {
+			"A": {"abi": [], "metadata": "{}", "evm": {"bytecode": {"object": "6001"}, "deployedBytecode": {"object": "01"}}}
+		}
+	}
+}`
+
+func TestCompileSources(t *testing.T) {
+	defer fakeSolc(t, compileOutput)()
+
+	result, err := compileSources(context.Background(), compileRequest)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	if want := []string{"experimental features"}; !reflect.DeepEqual(result.Warnings, want) {
+		t.Errorf("global warnings mismatch: have %q, want %q", result.Warnings, want)
+	}
+
+	want := []*ethereum.ContractArtifact{
+		{Source: "a.sol", Name: "A", Abi: "[]", Bytecode: "6001", RuntimeBytecode: "01", Metadata: "{}", Warnings: []string{"a.sol: unused variable"}},
+		{Source: "b.sol", Name: "B", Abi: "[]", Bytecode: "6002", RuntimeBytecode: "02", Warnings: []string{"b.sol: shadowed name"}},
+		{Source: "b.sol", Name: "C", Abi: "[]", Bytecode: "6003", RuntimeBytecode: "03", Warnings: []string{"b.sol: shadowed name"}},
+	}
+	if !reflect.DeepEqual(result.Contracts, want) {
+		t.Errorf("contracts mismatch:\nhave %v\nwant %v", result.Contracts, want)
+	}
+}
+
+func TestCompileSourcesSelection(t *testing.T) {
+	defer fakeSolc(t, compileOutput)()
+
+	req := *compileRequest
+	req.Contracts = []string{"b.sol:C"}
+
+	result, err := compileSources(context.Background(), &req)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	if len(result.Contracts) != 1 || result.Contracts[0].Name != "C" {
+		t.Fatalf("selection mismatch: have %v, want only C", result.Contracts)
+	}
+	// Warnings of source units left out are kept with the global ones
+	want := []string{"experimental features", "a.sol: unused variable"}
+	if !reflect.DeepEqual(result.Warnings, want) {
+		t.Errorf("global warnings mismatch: have %q, want %q", result.Warnings, want)
+	}
+}
+
+func TestCompileSourcesError(t *testing.T) {
+	defer fakeSolc(t, `{
+		"errors": [
+			{"severity": "warning", "formattedMessage": "a.sol: unused variable", "sourceLocation": {"file": "a.sol"}},
+			{"severity": "error", "formattedMessage": "a.sol: expected ';'", "sourceLocation": {"file": "a.sol"}}
+		]
+	}`)()
+
+	_, err := compileSources(context.Background(), compileRequest)
+	if code := grpc.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("error code mismatch: have %v, want %v (%v)", code, codes.InvalidArgument, err)
+	}
+	if msg := grpc.ErrorDesc(err); !strings.Contains(msg, "expected ';'") || strings.Contains(msg, "unused variable") {
+		t.Errorf("error message mismatch: have %q", msg)
+	}
+}
+
+func TestPickContract(t *testing.T) {
+	contracts := []*ethereum.ContractArtifact{
+		{Source: "a.sol", Name: "A"},
+		{Source: "b.sol", Name: "Token"},
+		{Source: "c.sol", Name: "Token"},
+	}
+	tests := []struct {
+		contracts []*ethereum.ContractArtifact
+		name      string
+		want      *ethereum.ContractArtifact
+		err       string
+	}{
+		{contracts: contracts[:1], name: "", want: contracts[0]},
+		{contracts: contracts, name: "A", want: contracts[0]},
+		{contracts: contracts, name: "c.sol:Token", want: contracts[2]},
+		{contracts: contracts, name: "", err: "ambiguous contract, pick one of a.sol:A, b.sol:Token, c.sol:Token"},
+		{contracts: contracts, name: "Token", err: "ambiguous contract, pick one of b.sol:Token, c.sol:Token"},
+		{contracts: contracts, name: "Missing", err: `contract "Missing" not found in sources`},
+	}
+	for i, tt := range tests {
+		contract, err := pickContract(tt.contracts, tt.name)
+		if tt.err != "" {
+			if err == nil || grpc.ErrorDesc(err) != tt.err {
+				t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %v", i, err)
+		} else if contract != tt.want {
+			t.Errorf("test %d: contract mismatch: have %v, want %v", i, contract, tt.want)
+		}
+	}
+}
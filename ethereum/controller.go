@@ -2,67 +2,377 @@ package ethereum
 
 import (
 	"crypto/ecdsa"
+	"errors"
+	"fmt"
 	"math"
 	"math/big"
-	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"github.com/alanchchen/ethermis/api"
 	"github.com/alanchchen/ethermis/api/ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/golang/glog"
 )
 
-func NewController() api.Controller {
-	key, _ := crypto.GenerateKey()
+// NewController creates the controller on top of the configured backend. The
+// stack is the embedded node, which is only used by the node backend.
+func NewController(stack *node.Node) (api.Controller, error) {
+	accman, err := makeAccountManager(stack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open keystore: %v", err)
+	}
+	unlocked, err := unlockAccounts(accman)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ethereumController{
+		accman: accman,
+		nonces: newNonceTracker(),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if len(unlocked) > 0 {
+		c.sender = unlocked[0]
+	}
+
+	switch backendType {
+	case backendSimulated:
+		// The simulated chain lives in memory only, so fall back to a
+		// throwaway key rather than requiring a keystore account
+		if len(unlocked) == 0 {
+			if c.key, err = crypto.GenerateKey(); err != nil {
+				return nil, err
+			}
+			c.sender = crypto.PubkeyToAddress(c.key.PublicKey)
+			unlocked = append(unlocked, c.sender)
+			glog.Warningf("No unlocked accounts, sending from ephemeral account %s", c.sender.Hex())
+		}
 
-	return &ethereumController{
-		key: key,
-		backend: backends.NewSimulatedBackend(
-			core.GenesisAccount{
-				Address: crypto.PubkeyToAddress(key.PublicKey),
+		var genesis []core.GenesisAccount
+		for _, address := range unlocked {
+			genesis = append(genesis, core.GenesisAccount{
+				Address: address,
 				Balance: big.NewInt(math.MaxInt64),
-			},
-		),
+			})
+		}
+		backend, err := newSimulatedBackend(genesis...)
+		if err != nil {
+			return nil, err
+		}
+		c.backend, c.simulated, c.events = backend, backend, backend.mux
+
+	case backendNode:
+		backend, err := attachRPCBackend(stack)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach to embedded node: %v", err)
+		}
+		c.backend = backend
+
+		// Light clients run no full Ethereum service, those are polled
+		var ethServ *Backend
+		if err := stack.Service(&ethServ); err == nil {
+			c.events = ethServ.Ethereum().EventMux()
+		}
+
+	case backendRPC:
+		backend, err := dialRPCBackend(rpcEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %v", rpcEndpoint, err)
+		}
+		c.backend = backend
+
+	default:
+		return nil, fmt.Errorf("unknown backend %q", backendType)
+	}
+
+	if c.sender == (common.Address{}) {
+		return nil, errors.New("no account to send transactions from, unlock one with --unlock and --password")
+	}
+
+	if c.simulated != nil {
+		c.commitMode = commitMode
+
+		switch commitMode {
+		case commitInstant, commitManual:
+		case commitInterval:
+			if commitPeriod <= 0 {
+				return nil, fmt.Errorf("invalid commit period %d", commitPeriod)
+			}
+		default:
+			return nil, fmt.Errorf("unknown commit mode %q", commitMode)
+		}
+	}
+
+	// Records are kept per chain, identified by its genesis block
+	genesis, err := c.backend.HeaderByNumber(context.Background(), common.Big0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve genesis block: %v", err)
 	}
+	// Opened last, so that no other failure leaves it open
+	if c.registry, err = openRegistry(genesis.Hash()); err != nil {
+		return nil, fmt.Errorf("failed to open contract registry: %v", err)
+	}
+
+	if c.commitMode == commitInterval {
+		go c.commitLoop(time.Duration(commitPeriod) * time.Second)
+	} else {
+		close(c.done)
+	}
+	return c, nil
 }
 
 // ----------------------------------------------------------------------------
 
 type ethereumController struct {
-	key     *ecdsa.PrivateKey
-	backend *backends.SimulatedBackend
+	accman *accounts.Manager
+	key    *ecdsa.PrivateKey // Ephemeral key of the simulated backend, if any
+	sender common.Address    // Default transaction sender
+
+	backend  chainBackend
+	events   *event.TypeMux // Chain and transaction pool events, nil if polling
+	registry *registry
+	nonces   *nonceTracker
+
+	simulated  *simulatedBackend // Set if backend is the simulated one
+	commitMode string
+
+	quit      chan struct{} // Closed to stop the commit loop
+	done      chan struct{} // Closed once the commit loop is stopped
+	closeOnce sync.Once
+}
+
+// Close stops committing blocks and closes the contract registry. Requests
+// must no longer be served by then.
+func (c *ethereumController) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.quit)
+		<-c.done
+		err = c.registry.close()
+	})
+	return err
 }
 
 func (c *ethereumController) Deploy(ctx context.Context, contract *ethereum.CompiledContract) (*ethereum.DeploymentInfo, error) {
-	auth := bind.NewKeyedTransactor(c.key)
-	auth.GasLimit = big.NewInt(4700000)
-	auth.Value = big.NewInt(47000000)
+	d, err := parseDeployment(contract)
+	if err != nil {
+		return nil, err
+	}
 
-	parsedABI, err := abi.JSON(strings.NewReader(contract.Abi))
+	auth, err := c.transactOpts(ctx, contract.Options)
 	if err != nil {
 		return nil, err
 	}
+	if err := c.registry.checkName(contract.Name, contract.Version, auth.From); err != nil {
+		return nil, registryError(err, contract.Name)
+	}
 
-	// Deploy a contract on the simulated blockchain
-	address, tx, _, err := bind.DeployContract(
-		auth,
-		parsedABI,
-		[]byte(contract.Code),
-		c.backend,
-		"Hello")
+	// Deploy a contract on the blockchain
+	var address common.Address
+	tx, err := c.send(ctx, auth, func() (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		address, tx, _, err = bind.DeployContract(
+			auth,
+			d.abi,
+			d.code,
+			c.backend,
+			d.args...)
+		return tx, err
+	})
 	if err != nil {
 		glog.Errorf("Failed to deploy new token contract: %v", err)
 		return nil, err
 	}
 
+	number, err := c.sent(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	c.register(ctx, contract, d, address, auth.From, tx.Hash(), number)
+
 	return &ethereum.DeploymentInfo{
 		DeployedAddress: address.Hex(),
 		TransactionId:   tx.Hash().Hex(),
+		BlockNumber:     number,
+	}, nil
+}
+
+func (c *ethereumController) Call(ctx context.Context, req *ethereum.CallRequest) (*ethereum.CallResult, error) {
+	address, abiJSON, err := c.resolveContract(req.Address, req.Abi)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := parseInvocation(address, abiJSON, req.Method, req.Args)
+	if err != nil {
+		return nil, err
+	}
+	if len(inv.method.Outputs) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %q has no outputs", req.Method)
+	}
+
+	// A single output is unpacked as is, multiple ones as a tuple
+	var (
+		single  interface{}
+		outputs []interface{}
+		result  interface{} = &outputs
+	)
+	if len(inv.method.Outputs) == 1 {
+		result = &single
+	}
+
+	contract := bind.NewBoundContract(inv.address, inv.abi, c.backend, c.backend)
+	opts := &bind.CallOpts{
+		Pending: req.Pending,
+		Context: ctx,
+	}
+	if err := contract.Call(opts, result, req.Method, inv.args...); err != nil {
+		glog.Errorf("Failed to call contract method %s: %v", req.Method, err)
+		return nil, err
+	}
+	if len(inv.method.Outputs) == 1 {
+		outputs = []interface{}{single}
+	}
+
+	values, err := toValues(inv.method.Outputs, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethereum.CallResult{
+		Outputs: values,
+	}, nil
+}
+
+func (c *ethereumController) Transact(ctx context.Context, req *ethereum.TransactRequest) (*ethereum.TransactionInfo, error) {
+	// Only Mine requests commit blocks in manual mode, waiting would hang
+	if req.Wait && c.simulated != nil && c.commitMode == commitManual {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "cannot wait for transactions in %s commit mode", commitManual)
+	}
+
+	address, abiJSON, err := c.resolveContract(req.Address, req.Abi)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := parseInvocation(address, abiJSON, req.Method, req.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := c.transactOpts(ctx, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := bind.NewBoundContract(inv.address, inv.abi, c.backend, c.backend)
+	tx, err := c.send(ctx, auth, func() (*types.Transaction, error) {
+		return contract.Transact(auth, req.Method, inv.args...)
+	})
+	if err != nil {
+		glog.Errorf("Failed to transact contract method %s: %v", req.Method, err)
+		return nil, err
+	}
+
+	number, err := c.sent(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ethereum.TransactionInfo{
+		TransactionId: tx.Hash().Hex(),
+		BlockNumber:   number,
+	}
+	if !req.Wait {
+		return info, nil
+	}
+
+	receipt, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if info.Receipt, err = c.receipt(ctx, tx, receipt, &inv.abi); err != nil {
+		return nil, err
+	}
+	info.BlockNumber = info.Receipt.BlockNumber
+
+	return info, nil
+}
+
+func (c *ethereumController) EstimateGas(ctx context.Context, req *ethereum.EstimateGasRequest) (*ethereum.GasEstimate, error) {
+	var (
+		msg     goethereum.CallMsg
+		options *ethereum.TxOptions
+		err     error
+	)
+
+	switch payload := req.Payload.(type) {
+	case *ethereum.EstimateGasRequest_Deploy:
+		d, err := parseDeployment(payload.Deploy)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Data, err = d.input(); err != nil {
+			return nil, err
+		}
+		options = payload.Deploy.Options
+
+	case *ethereum.EstimateGasRequest_Transact:
+		t := payload.Transact
+		address, abiJSON, err := c.resolveContract(t.Address, t.Abi)
+		if err != nil {
+			return nil, err
+		}
+		inv, err := parseInvocation(address, abiJSON, t.Method, t.Args)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Data, err = inv.input(); err != nil {
+			return nil, err
+		}
+		msg.To = &inv.address
+		options = t.Options
+
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "missing deploy or transact payload")
+	}
+
+	auth, err := c.transactOpts(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	msg.From, msg.Value = auth.From, auth.Value
+
+	gas, err := c.backend.EstimateGas(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := auth.GasPrice
+	if gasPrice == nil {
+		if gasPrice, err = c.backend.SuggestGasPrice(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ethereum.GasEstimate{
+		Gas:      gas.Uint64(),
+		GasPrice: gasPrice.String(),
+		Cost:     new(big.Int).Mul(gas, gasPrice).String(),
 	}, nil
 }
@@ -74,6 +74,10 @@ var (
 	gpoBaseStepDown         int
 	gpoBaseStepUp           int
 	gpoBaseCorrectionFactor int
+	backendType             string
+	rpcEndpoint             string
+	commitMode              string
+	commitPeriod            int
 )
 
 // These are all the command line flags we support.
@@ -337,4 +341,30 @@ func init() {
 		110,
 		"Suggested gas price base correction factor (%)",
 	)
+
+	// Controller backend settings
+	EthereumFlags.StringVar(&backendType,
+		"backend",
+		backendSimulated,
+		"Blockchain backend of the controller (simulated|node|rpc)",
+	)
+
+	EthereumFlags.StringVar(&rpcEndpoint,
+		"rpcendpoint",
+		"http://localhost:8545",
+		"JSON-RPC endpoint of the remote node used by the rpc backend",
+	)
+
+	// Simulated backend settings
+	EthereumFlags.StringVar(&commitMode,
+		"commit",
+		commitInstant,
+		"Block commit mode of the simulated backend (instant|interval|manual)",
+	)
+
+	EthereumFlags.IntVar(&commitPeriod,
+		"commitperiod",
+		5,
+		"Seconds between block commits in interval mode",
+	)
 }
@@ -0,0 +1,143 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"math/big"
+	"time"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// pollInterval is how often subscriptions check the backend for new blocks.
+const pollInterval = time.Second
+
+func (c *ethereumController) SubscribeLogs(filter *ethereum.LogFilter, stream ethereum.Ethereum_SubscribeLogsServer) error {
+	query, parsedABI, err := parseLogFilter(filter)
+	if err != nil {
+		return err
+	}
+	from, pending, err := parseBlock(filter.FromBlock)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return grpc.Errorf(codes.InvalidArgument, "cannot replay logs from the pending block")
+	}
+
+	ctx := stream.Context()
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Without a starting block only logs of blocks mined from now on are
+	// streamed
+	next := new(big.Int).Add(head.Number, common.Big1)
+	if from != nil {
+		next = from
+	}
+
+	// Send the headers right away, the gateway waits for them before
+	// answering REST clients
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if head.Number.Cmp(next) >= 0 {
+			query.FromBlock, query.ToBlock = next, head.Number
+			logs, err := c.backend.FilterLogs(ctx, query)
+			if err != nil {
+				return err
+			}
+			for i := range logs {
+				if err := stream.Send(newLog(&logs[i], parsedABI)); err != nil {
+					return err
+				}
+			}
+			next = new(big.Int).Add(head.Number, common.Big1)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if head, err = c.backend.HeaderByNumber(ctx, nil); err != nil {
+			return err
+		}
+	}
+}
+
+// parseLogFilter converts a log filter into a backend query, along with the
+// ABI to decode the matching logs with.
+func parseLogFilter(filter *ethereum.LogFilter) (goethereum.FilterQuery, *abi.ABI, error) {
+	var query goethereum.FilterQuery
+
+	for _, address := range filter.Addresses {
+		if !common.IsHexAddress(address) {
+			return query, nil, grpc.Errorf(codes.InvalidArgument, "invalid address %q", address)
+		}
+		query.Addresses = append(query.Addresses, common.HexToAddress(address))
+	}
+
+	var parsedABI *abi.ABI
+	if filter.Abi != "" {
+		parsed, err := parseABI(filter.Abi)
+		if err != nil {
+			return query, nil, err
+		}
+		parsedABI = &parsed
+	}
+
+	if filter.Event != "" {
+		if parsedABI == nil {
+			return query, nil, grpc.Errorf(codes.InvalidArgument, "event %q given without ABI", filter.Event)
+		}
+		event, ok := parsedABI.Events[filter.Event]
+		if !ok {
+			return query, nil, grpc.Errorf(codes.InvalidArgument, "event %q not found in ABI", filter.Event)
+		}
+		query.Topics = append(query.Topics, []common.Hash{event.Id()})
+	}
+
+	for i, alternatives := range filter.Topics {
+		var topics []common.Hash
+		for _, topic := range alternatives.Topics {
+			data, err := decodeHex(topic)
+			if err != nil || len(data) != common.HashLength {
+				return query, nil, grpc.Errorf(codes.InvalidArgument, "invalid topic %d %q", i, topic)
+			}
+			topics = append(topics, common.BytesToHash(data))
+		}
+		query.Topics = append(query.Topics, topics)
+	}
+
+	return query, parsedABI, nil
+}
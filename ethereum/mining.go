@@ -0,0 +1,106 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/logger/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// Block commit modes of the simulated backend
+const (
+	commitInstant  = "instant"  // Commit after every transaction
+	commitInterval = "interval" // Commit pending transactions periodically
+	commitManual   = "manual"   // Commit on explicit Mine requests only
+)
+
+// maxMineBlocks caps the blocks a single Mine request commits, as the
+// backend is held while doing so.
+const maxMineBlocks = 1000
+
+// commitLoop commits the pending transactions of the simulated backend every
+// period, skipping empty blocks, until the controller is closed.
+func (c *ethereumController) commitLoop(period time.Duration) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-ticker.C:
+		}
+
+		if len(c.simulated.PendingTransactions()) == 0 {
+			continue
+		}
+		if block, err := c.simulated.Commit(); err != nil {
+			glog.Errorf("Failed to commit block: %v", err)
+		} else {
+			glog.Infof("Committed block #%d with %d transactions", block.NumberU64(), len(block.Transactions()))
+		}
+	}
+}
+
+// sent commits the block holding tx if the simulated backend is in instant
+// mode, and returns the number of the block tx was included in, or zero if it
+// is still pending.
+func (c *ethereumController) sent(ctx context.Context, tx *types.Transaction) (uint64, error) {
+	if c.simulated != nil && c.commitMode == commitInstant {
+		if _, err := c.simulated.Commit(); err != nil {
+			return 0, err
+		}
+	}
+	_, number, err := c.backend.TransactionBlock(ctx, tx.Hash())
+	return number, err
+}
+
+func (c *ethereumController) Mine(ctx context.Context, req *ethereum.MineRequest) (*ethereum.MineResult, error) {
+	if c.simulated == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "mining on demand requires the %s backend", backendSimulated)
+	}
+
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = 1
+	}
+	if blocks > maxMineBlocks {
+		return nil, grpc.Errorf(codes.InvalidArgument, "cannot mine more than %d blocks at once", maxMineBlocks)
+	}
+
+	var block *types.Block
+	for i := uint32(0); i < blocks; i++ {
+		var err error
+		if block, err = c.simulated.Commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ethereum.MineResult{
+		BlockNumber: block.NumberU64(),
+		BlockHash:   block.Hash().Hex(),
+	}, nil
+}
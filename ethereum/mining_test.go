@@ -0,0 +1,47 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+func TestTransactWaitManualCommit(t *testing.T) {
+	backend, err := newSimulatedBackend()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &ethereumController{
+		backend:    backend,
+		simulated:  backend,
+		commitMode: commitManual,
+	}
+
+	req := &ethereum.TransactRequest{Address: "Token", Method: "transfer", Wait: true}
+	if _, err := c.Transact(context.Background(), req); grpc.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("error mismatch: have %v, want failed precondition", err)
+	}
+	if pending := backend.PendingTransactions(); len(pending) != 0 {
+		t.Fatalf("pending transactions mismatch: have %d, want 0", len(pending))
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/net/context"
+)
+
+// nonceTracker hands out the nonces of each sender one request at a time.
+// Without it, concurrent requests pick the same pending nonce and all but
+// one of their transactions are rejected or replaced.
+type nonceTracker struct {
+	mu      sync.Mutex
+	senders map[common.Address]*senderNonce
+}
+
+type senderNonce struct {
+	mu   sync.Mutex
+	next uint64 // Nonce after the last transaction sent, zero if unknown
+}
+
+func newNonceTracker() *nonceTracker {
+	return &nonceTracker{
+		senders: make(map[common.Address]*senderNonce),
+	}
+}
+
+// lock returns the locked nonce state of a sender.
+func (t *nonceTracker) lock(sender common.Address) *senderNonce {
+	t.mu.Lock()
+	s, ok := t.senders[sender]
+	if !ok {
+		s = new(senderNonce)
+		t.senders[sender] = s
+	}
+	t.mu.Unlock()
+
+	s.mu.Lock()
+	return s
+}
+
+// send sets the nonce of auth and sends its transaction while holding the
+// sender's lock. The nonce is the pending one of the backend, unless that
+// lags behind the transactions sent so far. Explicit nonces are used as is.
+func (c *ethereumController) send(ctx context.Context, auth *bind.TransactOpts, fn func() (*types.Transaction, error)) (*types.Transaction, error) {
+	if auth.Nonce != nil {
+		return fn()
+	}
+
+	s := c.nonces.lock(auth.From)
+	defer s.mu.Unlock()
+
+	nonce, err := c.backend.PendingNonceAt(ctx, auth.From)
+	if err != nil {
+		return nil, err
+	}
+	if s.next > nonce {
+		nonce = s.next
+	}
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+
+	tx, err := fn()
+	if err != nil {
+		// The backend may have dropped transactions, start over from its
+		// pending nonce
+		s.next = 0
+		return nil, err
+	}
+	s.next = nonce + 1
+	return tx, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// transactOpts builds the transaction options for a request. The sender
+// defaults to the first unlocked account, other fields left unset stay nil,
+// so the binding falls back to gas estimation, the suggested gas price and
+// zero value. A missing nonce is filled in by send.
+func (c *ethereumController) transactOpts(ctx context.Context, options *ethereum.TxOptions) (*bind.TransactOpts, error) {
+	auth := &bind.TransactOpts{
+		From:    c.sender,
+		Signer:  c.signTx,
+		Context: ctx,
+	}
+	if options == nil {
+		return auth, nil
+	}
+
+	if options.From != "" {
+		if !common.IsHexAddress(options.From) || !c.hasAccount(common.HexToAddress(options.From)) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "unknown sender account %q", options.From)
+		}
+		auth.From = common.HexToAddress(options.From)
+	}
+
+	var err error
+	if auth.GasLimit, err = parseQuantity("gas limit", options.GasLimit); err != nil {
+		return nil, err
+	}
+	if auth.GasPrice, err = parseQuantity("gas price", options.GasPrice); err != nil {
+		return nil, err
+	}
+	if auth.Value, err = parseQuantity("value", options.Value); err != nil {
+		return nil, err
+	}
+	if auth.Nonce, err = parseQuantity("nonce", options.Nonce); err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// parseQuantity parses an optional non-negative decimal or hex quantity,
+// returning nil if it is empty.
+func parseQuantity(name, s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	n, err := toBigInt(s)
+	if err == nil && n.Sign() < 0 {
+		err = fmt.Errorf("negative value %v", n)
+	}
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid %s: %v", name, err)
+	}
+	return n, nil
+}
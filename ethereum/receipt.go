@@ -0,0 +1,79 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/logger"
+	"github.com/ethereum/go-ethereum/logger/glog"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+const (
+	receiptStatusSuccess = "success"
+	receiptStatusFailed  = "failed"
+)
+
+// newReceipt converts a mined receipt of tx. Logs are decoded against
+// parsedABI when it is given.
+//
+// Receipts carry no execution status, so a transaction that used up all of
+// its gas is taken as failed, as that is what a throw does.
+func newReceipt(tx *types.Transaction, receipt *types.Receipt, parsedABI *abi.ABI) *ethereum.Receipt {
+	r := &ethereum.Receipt{
+		TransactionId:     receipt.TxHash.Hex(),
+		Status:            receiptStatusSuccess,
+		GasUsed:           receipt.GasUsed.Uint64(),
+		CumulativeGasUsed: receipt.CumulativeGasUsed.Uint64(),
+	}
+	if tx != nil && receipt.GasUsed.Cmp(tx.Gas()) >= 0 {
+		r.Status = receiptStatusFailed
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		r.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	for _, log := range receipt.Logs {
+		r.Logs = append(r.Logs, newLog(log, parsedABI))
+	}
+	return r
+}
+
+// newLog converts a log, decoding it against parsedABI when it is given.
+func newLog(log *types.Log, parsedABI *abi.ABI) *ethereum.Log {
+	l := &ethereum.Log{
+		Address:       log.Address.Hex(),
+		Data:          common.ToHex(log.Data),
+		BlockNumber:   log.BlockNumber,
+		TransactionId: log.TxHash.Hex(),
+		Index:         uint32(log.Index),
+	}
+	for _, topic := range log.Topics {
+		l.Topics = append(l.Topics, topic.Hex())
+	}
+
+	if parsedABI != nil {
+		event, fields, err := decodeLog(parsedABI, log)
+		if err != nil {
+			glog.V(logger.Debug).Infof("Failed to decode log %d of %s: %v", log.Index, log.TxHash.Hex(), err)
+		}
+		l.Event, l.Fields = event, fields
+	}
+	return l
+}
@@ -0,0 +1,493 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/logger"
+	"github.com/ethereum/go-ethereum/logger/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// registryPrefix prefixes the keys of contract records, followed by the
+// genesis hash of the chain and the contract address.
+var registryPrefix = []byte("contract-")
+
+var (
+	errContractNotFound = errors.New("contract not found")
+	errNameTaken        = errors.New("contract name registered by another deployer")
+)
+
+// contractRecord is a contract known to Ethermis.
+type contractRecord struct {
+	Name         string         `json:"name"`
+	Version      string         `json:"version"`
+	Abi          string         `json:"abi"`
+	BytecodeHash common.Hash    `json:"bytecodeHash"`
+	Address      common.Address `json:"address"`
+	Transaction  common.Hash    `json:"transaction"`
+	BlockNumber  uint64         `json:"blockNumber"`
+	Deployer     common.Address `json:"deployer"`
+	Tags         []string       `json:"tags"`
+	Registered   time.Time      `json:"registered"`
+}
+
+// registry persists contract records in a database under the data directory,
+// keyed by chain and contract address.
+type registry struct {
+	mu     sync.Mutex
+	db     *ethdb.LDBDatabase
+	prefix []byte // Key prefix of the records of the chain in use
+}
+
+// openRegistry opens the contract registry in the data directory, exposing
+// the records of the chain with the given genesis block only.
+func openRegistry(genesis common.Hash) (*registry, error) {
+	db, err := ethdb.NewLDBDatabase(filepath.Join(MakeDataDir(), "registry"), 16, 16)
+	if err != nil {
+		return nil, err
+	}
+	prefix := append(append([]byte{}, registryPrefix...), genesis[:]...)
+	return &registry{db: db, prefix: prefix}, nil
+}
+
+func (r *registry) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.db.Close()
+	return nil
+}
+
+// key returns the database key of a contract address.
+func (r *registry) key(address common.Address) []byte {
+	return append(append([]byte{}, r.prefix...), address[:]...)
+}
+
+// put stores a record, replacing any record of the same address.
+func (r *registry) put(record *contractRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.store(record)
+}
+
+// add stores a new registration, replacing any record of the same address.
+// Names registered by another deployer may only be reused for a new version.
+func (r *registry) add(record *contractRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records, err := r.records()
+	if err != nil {
+		return err
+	}
+	if err := checkName(records, record.Name, record.Version, record.Deployer); err != nil {
+		return err
+	}
+	return r.store(record)
+}
+
+// checkName checks whether a deployer may register a contract under a name
+// and version.
+func (r *registry) checkName(name, version string, deployer common.Address) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records, err := r.records()
+	if err != nil {
+		return err
+	}
+	return checkName(records, name, version, deployer)
+}
+
+func checkName(records []*contractRecord, name, version string, deployer common.Address) error {
+	if name == "" {
+		return nil
+	}
+	for _, record := range records {
+		if record.Name != name || record.Deployer == deployer {
+			continue
+		}
+		if version == "" || version == record.Version {
+			return errNameTaken
+		}
+	}
+	return nil
+}
+
+func (r *registry) store(record *contractRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return r.db.Put(r.key(record.Address), data)
+}
+
+// get returns the record of a contract address.
+func (r *registry) get(address common.Address) (*contractRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := r.db.Get(r.key(address))
+	if err != nil || len(data) == 0 {
+		return nil, errContractNotFound
+	}
+	record := new(contractRecord)
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// all returns every record, ordered by name, then by registration time.
+func (r *registry) all() ([]*contractRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records, err := r.records()
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(recordsByName(records))
+	return records, nil
+}
+
+// records returns every record of the chain in use, unordered. The caller
+// must hold the lock.
+func (r *registry) records() ([]*contractRecord, error) {
+	it := r.db.NewIterator()
+	defer it.Release()
+
+	var records []*contractRecord
+	for it.Next() {
+		if !bytes.HasPrefix(it.Key(), r.prefix) {
+			continue
+		}
+		record := new(contractRecord)
+		if err := json.Unmarshal(it.Value(), record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// lookup resolves a contract address, or a registered name optionally
+// suffixed by @version. Without a version the latest registration wins.
+func (r *registry) lookup(ref string) (*contractRecord, error) {
+	if common.IsHexAddress(ref) {
+		return r.get(common.HexToAddress(ref))
+	}
+
+	name, version := ref, ""
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		name, version = ref[:i], ref[i+1:]
+	}
+
+	records, err := r.all()
+	if err != nil {
+		return nil, err
+	}
+	var found *contractRecord
+	for _, record := range records {
+		if record.Name == name && (version == "" || record.Version == version) {
+			found = record
+		}
+	}
+	if found == nil {
+		return nil, errContractNotFound
+	}
+	return found, nil
+}
+
+// recordsByName sorts records by name, then by registration time.
+type recordsByName []*contractRecord
+
+func (r recordsByName) Len() int      { return len(r) }
+func (r recordsByName) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r recordsByName) Less(i, j int) bool {
+	if r[i].Name != r[j].Name {
+		return r[i].Name < r[j].Name
+	}
+	return r[i].Registered.Before(r[j].Registered)
+}
+
+// ----------------------------------------------------------------------------
+
+func (c *ethereumController) RegisterContract(ctx context.Context, info *ethereum.ContractInfo) (*ethereum.ContractInfo, error) {
+	if err := validateRegistration(info.Address, info.Abi, info.Name); err != nil {
+		return nil, err
+	}
+
+	record := &contractRecord{
+		Name:        info.Name,
+		Version:     info.Version,
+		Abi:         info.Abi,
+		Address:     common.HexToAddress(info.Address),
+		BlockNumber: info.BlockNumber,
+		Tags:        info.Tags,
+		Registered:  time.Now(),
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+		hash  *common.Hash
+	}{
+		{"bytecode hash", info.BytecodeHash, &record.BytecodeHash},
+		{"transaction id", info.TransactionId, &record.Transaction},
+	} {
+		if field.value == "" {
+			continue
+		}
+		data, err := decodeHex(field.value)
+		if err != nil || len(data) != common.HashLength {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid %s %q", field.name, field.value)
+		}
+		*field.hash = common.BytesToHash(data)
+	}
+	if info.Deployer != "" {
+		if !common.IsHexAddress(info.Deployer) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid deployer address %q", info.Deployer)
+		}
+		record.Deployer = common.HexToAddress(info.Deployer)
+	}
+
+	if err := c.registry.add(record); err != nil {
+		return nil, registryError(err, record.Name)
+	}
+	return newContractInfo(record), nil
+}
+
+func (c *ethereumController) ImportContract(ctx context.Context, req *ethereum.ImportContractRequest) (*ethereum.ContractInfo, error) {
+	abiJSON := req.Abi
+	if abiJSON == "" && req.Artifact != nil {
+		abiJSON = req.Artifact.Abi
+	}
+	if err := validateRegistration(req.Address, abiJSON, req.Name); err != nil {
+		return nil, err
+	}
+	address := common.HexToAddress(req.Address)
+
+	code, err := c.backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "no contract code at %s", address.Hex())
+	}
+	codeHash := crypto.Keccak256Hash(code)
+
+	if req.Artifact != nil {
+		runtime, err := decodeBytecode(req.Artifact.RuntimeBytecode)
+		if err != nil || len(runtime) == 0 {
+			return nil, grpc.Errorf(codes.InvalidArgument, "invalid runtime bytecode of artifact")
+		}
+		if crypto.Keccak256Hash(runtime) != codeHash {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "code at %s does not match the artifact", address.Hex())
+		}
+	}
+
+	record := &contractRecord{
+		Name:         req.Name,
+		Version:      req.Version,
+		Abi:          abiJSON,
+		BytecodeHash: codeHash,
+		Address:      address,
+		Tags:         req.Tags,
+		Registered:   time.Now(),
+	}
+	if err := c.registry.add(record); err != nil {
+		return nil, registryError(err, record.Name)
+	}
+	return newContractInfo(record), nil
+}
+
+func (c *ethereumController) ListContracts(ctx context.Context, req *ethereum.ListContractsRequest) (*ethereum.ContractList, error) {
+	records, err := c.registry.all()
+	if err != nil {
+		return nil, err
+	}
+
+	list := new(ethereum.ContractList)
+	for _, record := range records {
+		if req.Name != "" && record.Name != req.Name {
+			continue
+		}
+		if req.Tag != "" && !hasTag(record, req.Tag) {
+			continue
+		}
+		list.Contracts = append(list.Contracts, newContractInfo(c.updateBlock(ctx, record)))
+	}
+	return list, nil
+}
+
+func (c *ethereumController) GetContract(ctx context.Context, req *ethereum.GetContractRequest) (*ethereum.ContractInfo, error) {
+	record, err := c.registry.lookup(req.Contract)
+	if err == errContractNotFound {
+		return nil, grpc.Errorf(codes.NotFound, "contract %q not registered", req.Contract)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newContractInfo(c.updateBlock(ctx, record)), nil
+}
+
+// register records a contract deployed by the controller. The hash of its
+// runtime code is filled in once the deployment is mined.
+func (c *ethereumController) register(ctx context.Context, contract *ethereum.CompiledContract, d *deployment, address, deployer common.Address, txHash common.Hash, number uint64) {
+	record := &contractRecord{
+		Name:        contract.Name,
+		Version:     contract.Version,
+		Abi:         d.abiJSON,
+		Address:     address,
+		Transaction: txHash,
+		BlockNumber: number,
+		Deployer:    deployer,
+		Tags:        contract.Tags,
+		Registered:  time.Now(),
+	}
+	if number != 0 {
+		record.BytecodeHash = c.codeHash(ctx, address)
+	}
+	if err := c.registry.add(record); err != nil {
+		glog.Errorf("Failed to register contract %s: %v", address.Hex(), err)
+	}
+}
+
+// resolveContract resolves a contract reference of a call or transaction,
+// defaulting the ABI to the registered one.
+func (c *ethereumController) resolveContract(ref, abiJSON string) (string, string, error) {
+	if common.IsHexAddress(ref) && abiJSON != "" {
+		return ref, abiJSON, nil
+	}
+
+	record, err := c.registry.lookup(ref)
+	if err == errContractNotFound {
+		if !common.IsHexAddress(ref) {
+			return "", "", grpc.Errorf(codes.NotFound, "contract %q not registered", ref)
+		}
+		return ref, abiJSON, nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if abiJSON == "" {
+		abiJSON = record.Abi
+	}
+	return record.Address.Hex(), abiJSON, nil
+}
+
+// updateBlock fills in the block and code hash of a deployment that was
+// pending when it got registered.
+func (c *ethereumController) updateBlock(ctx context.Context, record *contractRecord) *contractRecord {
+	if record.BlockNumber != 0 || record.Transaction == (common.Hash{}) {
+		return record
+	}
+	hash, number, err := c.backend.TransactionBlock(ctx, record.Transaction)
+	if err != nil || hash == (common.Hash{}) {
+		return record
+	}
+	record.BlockNumber = number
+	record.BytecodeHash = c.codeHash(ctx, record.Address)
+	if err := c.registry.put(record); err != nil {
+		glog.Errorf("Failed to update contract %s: %v", record.Address.Hex(), err)
+	}
+	return record
+}
+
+// codeHash returns the hash of the runtime code at an address, or the zero
+// hash if it cannot be retrieved.
+func (c *ethereumController) codeHash(ctx context.Context, address common.Address) common.Hash {
+	code, err := c.backend.CodeAt(ctx, address, nil)
+	if err != nil || len(code) == 0 {
+		glog.V(logger.Debug).Infof("No code at contract %s: %v", address.Hex(), err)
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(code)
+}
+
+// validateRegistration checks the address, ABI and name a contract gets
+// registered with. Names must not be taken for addresses or versions.
+func validateRegistration(address, abiJSON, name string) error {
+	if !common.IsHexAddress(address) {
+		return grpc.Errorf(codes.InvalidArgument, "invalid contract address %q", address)
+	}
+	if _, err := parseABI(abiJSON); err != nil {
+		return err
+	}
+	if strings.Contains(name, "@") || common.IsHexAddress(name) {
+		return grpc.Errorf(codes.InvalidArgument, "invalid contract name %q", name)
+	}
+	return nil
+}
+
+// registryError converts a registration failure to a gRPC error.
+func registryError(err error, name string) error {
+	if err == errNameTaken {
+		return grpc.Errorf(codes.AlreadyExists, "contract name %q registered by another deployer, register a new version", name)
+	}
+	return err
+}
+
+func hasTag(record *contractRecord, tag string) bool {
+	for _, t := range record.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func newContractInfo(record *contractRecord) *ethereum.ContractInfo {
+	info := &ethereum.ContractInfo{
+		Name:        record.Name,
+		Version:     record.Version,
+		Abi:         record.Abi,
+		Address:     record.Address.Hex(),
+		BlockNumber: record.BlockNumber,
+		Tags:        record.Tags,
+	}
+	if record.BytecodeHash != (common.Hash{}) {
+		info.BytecodeHash = record.BytecodeHash.Hex()
+	}
+	if record.Transaction != (common.Hash{}) {
+		info.TransactionId = record.Transaction.Hex()
+	}
+	if record.Deployer != (common.Address{}) {
+		info.Deployer = record.Deployer.Hex()
+	}
+	return info
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCheckName(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		bob   = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	)
+	records := []*contractRecord{
+		{Name: "Token", Version: "1.0", Deployer: alice},
+		{Name: "Token", Version: "1.1", Deployer: alice},
+		{Name: "", Deployer: alice},
+	}
+	tests := []struct {
+		name     string
+		version  string
+		deployer common.Address
+		err      error
+	}{
+		{"Token", "", alice, nil},
+		{"Token", "1.0", alice, nil},
+		{"Token", "2.0", bob, nil},
+		{"Token", "", bob, errNameTaken},
+		{"Token", "1.1", bob, errNameTaken},
+		{"Other", "", bob, nil},
+		{"", "", bob, nil},
+	}
+	for i, tt := range tests {
+		if err := checkName(records, tt.name, tt.version, tt.deployer); err != tt.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// deployment is a validated contract deployment request.
+type deployment struct {
+	abi     abi.ABI
+	abiJSON string
+	code    []byte
+	args    []interface{}
+}
+
+// parseDeployment validates the contract code, ABI and constructor arguments
+// of a deployment request.
+func parseDeployment(contract *ethereum.CompiledContract) (*deployment, error) {
+	abiJSON, code, err := decodeContract(contract)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid contract code: %v", err)
+	}
+
+	parsedABI, err := parseABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := convertArgs(parsedABI.Constructor.Inputs, contract.Args)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "constructor %v", err)
+	}
+
+	return &deployment{
+		abi:     parsedABI,
+		abiJSON: abiJSON,
+		code:    code,
+		args:    args,
+	}, nil
+}
+
+// input returns the creation transaction payload.
+func (d *deployment) input() ([]byte, error) {
+	packed, err := d.abi.Pack("", d.args...)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "constructor: %v", err)
+	}
+	return append(common.CopyBytes(d.code), packed...), nil
+}
+
+// invocation is a validated contract method invocation request.
+type invocation struct {
+	address common.Address
+	abi     abi.ABI
+	method  abi.Method
+	args    []interface{}
+}
+
+// parseInvocation validates the contract address, ABI, method and arguments
+// of a call or transaction request.
+func parseInvocation(address, abiJSON, method string, args []string) (*invocation, error) {
+	if !common.IsHexAddress(address) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid contract address %q", address)
+	}
+
+	parsedABI, err := parseABI(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := parsedABI.Methods[method]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %q not found in ABI", method)
+	}
+
+	values, err := convertArgs(m.Inputs, args)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %s %v", method, err)
+	}
+
+	return &invocation{
+		address: common.HexToAddress(address),
+		abi:     parsedABI,
+		method:  m,
+		args:    values,
+	}, nil
+}
+
+// input returns the transaction payload invoking the method.
+func (inv *invocation) input() ([]byte, error) {
+	packed, err := inv.abi.Pack(inv.method.Name, inv.args...)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "method %s: %v", inv.method.Name, err)
+	}
+	return packed, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/node"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// makeAccountManager returns the account manager of the embedded node, or
+// opens the keystore configured by the command line flags if there is none.
+func makeAccountManager(stack *node.Node) (*accounts.Manager, error) {
+	if stack != nil {
+		return stack.AccountManager(), nil
+	}
+
+	keydir := keyStoreDir
+	if keydir == "" {
+		keydir = filepath.Join(MakeDataDir(), "keystore")
+	}
+	if err := os.MkdirAll(keydir, 0700); err != nil {
+		return nil, err
+	}
+
+	scryptN, scryptP := accounts.StandardScryptN, accounts.StandardScryptP
+	if lightKDF {
+		scryptN, scryptP = accounts.LightScryptN, accounts.LightScryptP
+	}
+	return accounts.NewManager(keydir, scryptN, scryptP), nil
+}
+
+// unlockAccounts unlocks the accounts given by --unlock with the passwords
+// read from --password, returning their addresses in the same order.
+func unlockAccounts(accman *accounts.Manager) ([]common.Address, error) {
+	if unlockedAccount == "" {
+		return nil, nil
+	}
+
+	var (
+		passwords = MakePasswordList()
+		unlocked  []common.Address
+	)
+	for i, input := range strings.Split(unlockedAccount, ",") {
+		account, err := MakeAddress(accman, strings.TrimSpace(input))
+		if err != nil {
+			return nil, err
+		}
+
+		// Like geth, reuse the last password for any remaining accounts
+		var password string
+		if len(passwords) > 0 {
+			password = passwords[len(passwords)-1]
+			if i < len(passwords) {
+				password = passwords[i]
+			}
+		}
+		if err := accman.Unlock(account, password); err != nil {
+			return nil, fmt.Errorf("failed to unlock account %s: %v", account.Address.Hex(), err)
+		}
+		unlocked = append(unlocked, account.Address)
+	}
+	return unlocked, nil
+}
+
+// hasAccount reports whether the controller holds the key of an address.
+func (c *ethereumController) hasAccount(address common.Address) bool {
+	if c.key != nil && address == crypto.PubkeyToAddress(c.key.PublicKey) {
+		return true
+	}
+	return c.accman.HasAddress(address)
+}
+
+// signTx signs a transaction with the ephemeral key or a keystore account,
+// which must have been unlocked beforehand.
+func (c *ethereumController) signTx(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if c.key != nil && address == crypto.PubkeyToAddress(c.key.PublicKey) {
+		return bind.NewKeyedTransactor(c.key).Signer(signer, address, tx)
+	}
+
+	signature, err := c.accman.Sign(address, signer.Hash(tx).Bytes())
+	if err == accounts.ErrLocked {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "account %s is locked", address.Hex())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, signature)
+}
@@ -0,0 +1,468 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"golang.org/x/net/context"
+)
+
+// Chain configuration with all forks active from block 0. EIP155 signers need
+// the chain ID, even though transactions are signed without replay protection.
+var simulatedChainConfig = &params.ChainConfig{
+	ChainId:        big.NewInt(1337),
+	HomesteadBlock: big.NewInt(0),
+	EIP150Block:    new(big.Int),
+	EIP155Block:    new(big.Int),
+	EIP158Block:    new(big.Int),
+}
+
+// This nil assignment ensures compile time that simulatedBackend implements chainBackend.
+var _ chainBackend = (*simulatedBackend)(nil)
+
+// simulatedBackend is an in-memory blockchain, adapted from go-ethereum's
+// backends.SimulatedBackend. Unlike the original, it exposes the chain it
+// builds, leaves committing to its owner and reports invalid transactions as
+// errors rather than panicking.
+type simulatedBackend struct {
+	database   ethdb.Database   // In memory database to store our testing data
+	blockchain *core.BlockChain // Ethereum blockchain to handle the consensus
+	mux        *event.TypeMux   // Chain events, along with new pending transactions
+
+	mu           sync.Mutex
+	pendingBlock *types.Block   // Currently pending block that will be imported on request
+	pendingState *state.StateDB // Currently pending state that will be the active on on request
+}
+
+// newSimulatedBackend creates a new in-memory blockchain whose genesis block
+// funds the given accounts.
+func newSimulatedBackend(accounts ...core.GenesisAccount) (*simulatedBackend, error) {
+	database, err := ethdb.NewMemDatabase()
+	if err != nil {
+		return nil, err
+	}
+	core.WriteGenesisBlockForTesting(database, accounts...)
+	mux := new(event.TypeMux)
+	blockchain, err := core.NewBlockChain(database, simulatedChainConfig, new(core.FakePow), mux)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &simulatedBackend{
+		database:   database,
+		blockchain: blockchain,
+		mux:        mux,
+	}
+	backend.rollback()
+	return backend, nil
+}
+
+// Commit imports all the pending transactions as a single block and starts a
+// fresh new state. It returns the imported block.
+func (b *simulatedBackend) Commit() (*types.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	block := b.pendingBlock
+	if _, err := b.blockchain.InsertChain([]*types.Block{block}); err != nil {
+		return nil, err
+	}
+	b.rollback()
+	return block, nil
+}
+
+// Rollback aborts all pending transactions, reverting to the last committed state.
+func (b *simulatedBackend) Rollback() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rollback()
+}
+
+func (b *simulatedBackend) rollback() {
+	blocks, _ := core.GenerateChain(simulatedChainConfig, b.blockchain.CurrentBlock(), b.database, 1, func(int, *core.BlockGen) {})
+	b.pendingBlock = blocks[0]
+	b.pendingState, _ = state.New(b.pendingBlock.Root(), b.database)
+}
+
+// PendingTransactions returns the transactions waiting for the next commit.
+func (b *simulatedBackend) PendingTransactions() types.Transactions {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingBlock.Transactions()
+}
+
+// TransactionByHash returns a committed or pending transaction.
+func (b *simulatedBackend) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if tx := b.pendingBlock.Transaction(txHash); tx != nil {
+		return tx, nil
+	}
+	if tx, _, _, _ := core.GetTransaction(b.database, txHash); tx != nil {
+		return tx, nil
+	}
+	return nil, goethereum.NotFound
+}
+
+// TransactionBlock returns the hash and number of the block holding the given
+// transaction, the hash being zero if it has not been committed yet.
+func (b *simulatedBackend) TransactionBlock(ctx context.Context, txHash common.Hash) (common.Hash, uint64, error) {
+	_, hash, number, _ := core.GetTransaction(b.database, txHash)
+	return hash, number, nil
+}
+
+// BlockByNumber returns a committed block, or the latest one if number is nil.
+func (b *simulatedBackend) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	if number == nil {
+		return b.blockchain.CurrentBlock(), nil
+	}
+	block := b.blockByNumber(number)
+	if block == nil {
+		return nil, goethereum.NotFound
+	}
+	return block, nil
+}
+
+// BlockByHash returns a committed block.
+func (b *simulatedBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	block := b.blockchain.GetBlockByHash(hash)
+	if block == nil {
+		return nil, goethereum.NotFound
+	}
+	return block, nil
+}
+
+// HeaderByNumber returns the header of a committed block, or of the latest
+// one if number is nil.
+func (b *simulatedBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	block, err := b.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (b *simulatedBackend) blockByNumber(number *big.Int) *types.Block {
+	if number.Sign() < 0 || number.BitLen() > 64 {
+		return nil
+	}
+	return b.blockchain.GetBlockByNumber(number.Uint64())
+}
+
+// CodeAt returns the code associated with a certain account in the blockchain.
+func (b *simulatedBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	statedb, err := b.stateAt(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetCode(contract), nil
+}
+
+// BalanceAt returns the wei balance of a certain account in the blockchain.
+func (b *simulatedBackend) BalanceAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (*big.Int, error) {
+	statedb, err := b.stateAt(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return statedb.GetBalance(contract), nil
+}
+
+// NonceAt returns the nonce of a certain account in the blockchain.
+func (b *simulatedBackend) NonceAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (uint64, error) {
+	statedb, err := b.stateAt(blockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return statedb.GetNonce(contract), nil
+}
+
+// StorageAt returns the value of key in the storage of an account in the blockchain.
+func (b *simulatedBackend) StorageAt(ctx context.Context, contract common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	statedb, err := b.stateAt(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	val := statedb.GetState(contract, key)
+	return val[:], nil
+}
+
+// stateAt returns the state at the given block, or the latest one if
+// blockNumber is nil. Nothing is ever pruned from the in-memory database,
+// so the state of every committed block is available.
+func (b *simulatedBackend) stateAt(blockNumber *big.Int) (*state.StateDB, error) {
+	if blockNumber == nil {
+		return b.blockchain.State()
+	}
+	block := b.blockByNumber(blockNumber)
+	if block == nil {
+		return nil, goethereum.NotFound
+	}
+	return state.New(block.Root(), b.database)
+}
+
+// TransactionReceipt returns the receipt of a transaction.
+func (b *simulatedBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return core.GetReceipt(b.database, txHash), nil
+}
+
+// FilterLogs returns the logs of the committed blocks matching the query.
+func (b *simulatedBackend) FilterLogs(ctx context.Context, query goethereum.FilterQuery) ([]types.Log, error) {
+	var (
+		from = uint64(0)
+		to   = b.blockchain.CurrentBlock().NumberU64()
+	)
+	if query.FromBlock != nil {
+		from = query.FromBlock.Uint64()
+	}
+	if query.ToBlock != nil && query.ToBlock.Uint64() < to {
+		to = query.ToBlock.Uint64()
+	}
+
+	var logs []types.Log
+	for number := from; number <= to; number++ {
+		block := b.blockchain.GetBlockByNumber(number)
+		if block == nil {
+			break
+		}
+		for _, receipt := range core.GetBlockReceipts(b.database, block.Hash(), number) {
+			for _, log := range receipt.Logs {
+				if matchLog(log, query) {
+					logs = append(logs, *log)
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+// matchLog reports whether a log matches the addresses and topics of a query.
+func matchLog(log *types.Log, query goethereum.FilterQuery) bool {
+	if len(query.Addresses) > 0 {
+		var found bool
+		for _, address := range query.Addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for i, alternatives := range query.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) {
+			return false
+		}
+		var found bool
+		for _, topic := range alternatives {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// PendingBalanceAt returns the wei balance of an account in the pending state.
+func (b *simulatedBackend) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingState.GetBalance(account), nil
+}
+
+// PendingStorageAt returns the value of key in the storage of an account in the pending state.
+func (b *simulatedBackend) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	val := b.pendingState.GetState(account, key)
+	return val[:], nil
+}
+
+// PendingCodeAt returns the code associated with an account in the pending state.
+func (b *simulatedBackend) PendingCodeAt(ctx context.Context, contract common.Address) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingState.GetCode(contract), nil
+}
+
+// CallContract executes a contract call.
+func (b *simulatedBackend) CallContract(ctx context.Context, call goethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	statedb, err := b.stateAt(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	// The call sees the block it runs on, not the latest one
+	block := b.blockchain.CurrentBlock()
+	if blockNumber != nil {
+		block = b.blockByNumber(blockNumber)
+	}
+	rval, _, err := b.callContract(ctx, call, block, statedb)
+	return rval, err
+}
+
+// PendingCallContract executes a contract call on the pending state.
+func (b *simulatedBackend) PendingCallContract(ctx context.Context, call goethereum.CallMsg) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer b.pendingState.RevertToSnapshot(b.pendingState.Snapshot())
+
+	rval, _, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
+	return rval, err
+}
+
+// PendingNonceAt implements PendingStateReader.PendingNonceAt, retrieving
+// the nonce currently pending for the account.
+func (b *simulatedBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pendingState.GetNonce(account), nil
+}
+
+// SuggestGasPrice implements ContractTransactor.SuggestGasPrice. Since the simulated
+// chain doesn't have miners, we just return a gas price of 1 for any call.
+func (b *simulatedBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+// EstimateGas executes the requested code against the currently pending block/state and
+// returns the used amount of gas.
+func (b *simulatedBackend) EstimateGas(ctx context.Context, call goethereum.CallMsg) (*big.Int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer b.pendingState.RevertToSnapshot(b.pendingState.Snapshot())
+
+	_, gas, err := b.callContract(ctx, call, b.pendingBlock, b.pendingState)
+	return gas, err
+}
+
+// callContract implements common code between normal and pending contract calls.
+// state is modified during execution, make sure to copy it if necessary.
+func (b *simulatedBackend) callContract(ctx context.Context, call goethereum.CallMsg, block *types.Block, statedb *state.StateDB) ([]byte, *big.Int, error) {
+	// Ensure message is initialized properly.
+	if call.GasPrice == nil {
+		call.GasPrice = big.NewInt(1)
+	}
+	if call.Gas == nil || call.Gas.BitLen() == 0 {
+		call.Gas = big.NewInt(50000000)
+	}
+	if call.Value == nil {
+		call.Value = new(big.Int)
+	}
+	// Set infinite balance to the fake caller account.
+	statedb.SetBalance(call.From, common.MaxBig)
+	// Execute the call.
+	msg := callmsg{call}
+
+	evmContext := core.NewEVMContext(msg, block.Header(), b.blockchain)
+	// Create a new environment which holds all relevant information
+	// about the transaction and calling mechanisms.
+	vmenv := vm.NewEVM(evmContext, statedb, simulatedChainConfig, vm.Config{})
+	gaspool := new(core.GasPool).AddGas(common.MaxBig)
+	ret, gasUsed, _, err := core.NewStateTransition(vmenv, msg, gaspool).TransitionDb()
+	return ret, gasUsed, err
+}
+
+// SendTransaction updates the pending block to include the given transaction.
+func (b *simulatedBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.addTransaction(tx); err != nil {
+		return err
+	}
+	// Posting blocks until subscribers took the event, so do it unlocked
+	b.mux.Post(core.TxPreEvent{Tx: tx})
+	return nil
+}
+
+func (b *simulatedBackend) addTransaction(tx *types.Transaction) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sender, err := types.Sender(types.HomesteadSigner{}, tx)
+	if err != nil {
+		return fmt.Errorf("invalid transaction: %v", err)
+	}
+	nonce := b.pendingState.GetNonce(sender)
+	if tx.Nonce() != nonce {
+		return fmt.Errorf("invalid transaction nonce: got %d, want %d", tx.Nonce(), nonce)
+	}
+
+	// BlockGen panics on transactions it cannot apply, report those instead
+	var blocks []*types.Block
+	err = func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("invalid transaction: %v", r)
+			}
+		}()
+		blocks, _ = core.GenerateChain(simulatedChainConfig, b.blockchain.CurrentBlock(), b.database, 1, func(number int, block *core.BlockGen) {
+			for _, tx := range b.pendingBlock.Transactions() {
+				block.AddTx(tx)
+			}
+			block.AddTx(tx)
+		})
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+	b.pendingBlock = blocks[0]
+	b.pendingState, _ = state.New(b.pendingBlock.Root(), b.database)
+	return nil
+}
+
+// callmsg implements core.Message to allow passing it as a transaction simulator.
+type callmsg struct {
+	goethereum.CallMsg
+}
+
+func (m callmsg) From() (common.Address, error) { return m.CallMsg.From, nil }
+func (m callmsg) Nonce() uint64                 { return 0 }
+func (m callmsg) CheckNonce() bool              { return false }
+func (m callmsg) To() *common.Address           { return m.CallMsg.To }
+func (m callmsg) GasPrice() *big.Int            { return m.CallMsg.GasPrice }
+func (m callmsg) Gas() *big.Int                 { return m.CallMsg.Gas }
+func (m callmsg) Value() *big.Int               { return m.CallMsg.Value }
+func (m callmsg) Data() []byte                  { return m.CallMsg.Data }
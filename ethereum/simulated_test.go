@@ -0,0 +1,103 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/net/context"
+)
+
+// newTestBackend creates a simulated backend funding a new key.
+func newTestBackend(t *testing.T) (*simulatedBackend, *bind.TransactOpts) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := bind.NewKeyedTransactor(key)
+	backend, err := newSimulatedBackend(core.GenesisAccount{Address: auth.From, Balance: big.NewInt(math.MaxInt64)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return backend, auth
+}
+
+func TestSimulatedSendTransaction(t *testing.T) {
+	backend, auth := newTestBackend(t)
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tx := types.NewTransaction(0, to, big.NewInt(1), big.NewInt(21000), big.NewInt(1), nil)
+	tx, err := auth.Signer(types.HomesteadSigner{}, auth.From, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	if _, err := backend.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	balance, err := backend.BalanceAt(context.Background(), to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("balance mismatch: have %v, want 1", balance)
+	}
+}
+
+// blockNumberCode deploys a contract returning the number of the block it is
+// called on.
+var blockNumberCode = common.FromHex("6009600c60003960096000f34360005260206000f3")
+
+func TestCallContractAtBlock(t *testing.T) {
+	backend, auth := newTestBackend(t)
+
+	parsed, err := abi.JSON(strings.NewReader("[]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, _, _, err := bind.DeployContract(auth, parsed, blockNumberCode, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := backend.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, number := range []int64{1, 2, 3} {
+		out, err := backend.CallContract(context.Background(), goethereum.CallMsg{To: &address}, big.NewInt(number))
+		if err != nil {
+			t.Fatalf("block %d: call failed: %v", number, err)
+		}
+		if have := new(big.Int).SetBytes(out); have.Int64() != number {
+			t.Errorf("block %d: block number mismatch: have %v, want %d", number, have, number)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"math/big"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// Block tags accepted in place of a block number
+const (
+	blockLatest  = "latest"
+	blockPending = "pending"
+)
+
+func (c *ethereumController) GetBalance(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Balance, error) {
+	address, number, pending, err := parseStateRequest(req.Address, req.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var balance *big.Int
+	if pending {
+		balance, err = c.backend.PendingBalanceAt(ctx, address)
+	} else {
+		balance, err = c.backend.BalanceAt(ctx, address, number)
+	}
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	return &ethereum.Balance{
+		Balance: balance.String(),
+	}, nil
+}
+
+func (c *ethereumController) GetNonce(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Nonce, error) {
+	address, number, pending, err := parseStateRequest(req.Address, req.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce uint64
+	if pending {
+		nonce, err = c.backend.PendingNonceAt(ctx, address)
+	} else {
+		nonce, err = c.backend.NonceAt(ctx, address, number)
+	}
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	return &ethereum.Nonce{
+		Nonce: nonce,
+	}, nil
+}
+
+func (c *ethereumController) GetCode(ctx context.Context, req *ethereum.StateRequest) (*ethereum.Code, error) {
+	address, number, pending, err := parseStateRequest(req.Address, req.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var code []byte
+	if pending {
+		code, err = c.backend.PendingCodeAt(ctx, address)
+	} else {
+		code, err = c.backend.CodeAt(ctx, address, number)
+	}
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	result := new(ethereum.Code)
+	if len(code) > 0 {
+		result.Code = common.ToHex(code)
+	}
+	return result, nil
+}
+
+func (c *ethereumController) GetStorageAt(ctx context.Context, req *ethereum.StorageRequest) (*ethereum.Storage, error) {
+	address, number, pending, err := parseStateRequest(req.Address, req.Block)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := toBigInt(req.Key)
+	if err != nil || slot.Sign() < 0 || slot.BitLen() > 256 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid storage key %q", req.Key)
+	}
+	key := common.BigToHash(slot)
+
+	var value []byte
+	if pending {
+		value, err = c.backend.PendingStorageAt(ctx, address, key)
+	} else {
+		value, err = c.backend.StorageAt(ctx, address, key, number)
+	}
+	if err != nil {
+		return nil, stateError(err)
+	}
+
+	return &ethereum.Storage{
+		Value: common.BytesToHash(value).Hex(),
+	}, nil
+}
+
+// parseStateRequest validates the account address and block of a state query.
+func parseStateRequest(address, block string) (common.Address, *big.Int, bool, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, nil, false, grpc.Errorf(codes.InvalidArgument, "invalid address %q", address)
+	}
+	number, pending, err := parseBlock(block)
+	if err != nil {
+		return common.Address{}, nil, false, err
+	}
+	return common.HexToAddress(address), number, pending, nil
+}
+
+// parseBlock parses a block number or tag, returning a nil number for the
+// latest block and true for the pending one.
+func parseBlock(block string) (*big.Int, bool, error) {
+	switch block {
+	case "", blockLatest:
+		return nil, false, nil
+	case blockPending:
+		return nil, true, nil
+	}
+
+	number, err := toBigInt(block)
+	if err != nil || number.Sign() < 0 {
+		return nil, false, grpc.Errorf(codes.InvalidArgument, "invalid block %q, want a number, %q or %q", block, blockLatest, blockPending)
+	}
+	return number, false, nil
+}
+
+// stateError maps a missing block to NotFound.
+func stateError(err error) error {
+	if err == goethereum.NotFound {
+		return grpc.Errorf(codes.NotFound, "block not found")
+	}
+	return err
+}
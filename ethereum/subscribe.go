@@ -0,0 +1,187 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"math/big"
+	"time"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/event"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+// eventQueueSize bounds the events buffered for a single subscriber.
+const eventQueueSize = 256
+
+// errSlowSubscriber ends a subscription whose client falls too far behind.
+var errSlowSubscriber = grpc.Errorf(codes.ResourceExhausted, "subscriber cannot keep up with events")
+
+// eventQueue buffers the events of a mux subscription, so that a slow client
+// never blocks posting to the mux, and with it every other user of it.
+type eventQueue struct {
+	C <-chan *event.Event
+
+	overflowed bool // Whether events were lost, valid once C is closed
+}
+
+// newEventQueue drains sub into a bounded queue until the subscription ends.
+// Should the queue fill up, the subscription is ended instead of blocking.
+func newEventQueue(sub event.Subscription) *eventQueue {
+	events := make(chan *event.Event, eventQueueSize)
+	q := &eventQueue{C: events}
+
+	go func() {
+		defer close(events)
+
+		for ev := range sub.Chan() {
+			select {
+			case events <- ev:
+			default:
+				q.overflowed = true
+				sub.Unsubscribe()
+				return
+			}
+		}
+	}()
+	return q
+}
+
+// err returns the reason the queue was closed, if any.
+func (q *eventQueue) err() error {
+	if q.overflowed {
+		return errSlowSubscriber
+	}
+	return nil
+}
+
+// pendingTxWatcher is implemented by backends that can be polled for new
+// pending transactions.
+type pendingTxWatcher interface {
+	watchPendingTransactions(ctx context.Context, fn func(common.Hash) error) error
+}
+
+func (c *ethereumController) SubscribeNewHeads(req *ethereum.NewHeadsRequest, stream ethereum.Ethereum_SubscribeNewHeadsServer) error {
+	// Let the gateway answer REST clients before the first head arrives
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	if c.events != nil {
+		sub := c.events.Subscribe(core.ChainHeadEvent{})
+		defer sub.Unsubscribe()
+		queue := newEventQueue(sub)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case ev, ok := <-queue.C:
+				if !ok {
+					return queue.err()
+				}
+				head := ev.Data.(core.ChainHeadEvent)
+				if err := stream.Send(newBlock(head.Block, false)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	next := new(big.Int).Add(head.Number, common.Big1)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if head, err = c.backend.HeaderByNumber(ctx, nil); err != nil {
+			return err
+		}
+		for ; next.Cmp(head.Number) <= 0; next = new(big.Int).Add(next, common.Big1) {
+			block, err := c.backend.BlockByNumber(ctx, next)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(newBlock(block, false)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *ethereumController) SubscribePendingTransactions(req *ethereum.PendingTransactionsRequest, stream ethereum.Ethereum_SubscribePendingTransactionsServer) error {
+	watcher, polling := c.backend.(pendingTxWatcher)
+	if c.events == nil && !polling {
+		return grpc.Errorf(codes.Unimplemented, "backend cannot report pending transactions")
+	}
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	if c.events != nil {
+		sub := c.events.Subscribe(core.TxPreEvent{})
+		defer sub.Unsubscribe()
+		queue := newEventQueue(sub)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case ev, ok := <-queue.C:
+				if !ok {
+					return queue.err()
+				}
+				tx := ev.Data.(core.TxPreEvent).Tx
+				if err := stream.Send(newTransaction(tx)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return watcher.watchPendingTransactions(ctx, func(hash common.Hash) error {
+		tx, err := c.backend.TransactionByHash(ctx, hash)
+		if err == goethereum.NotFound {
+			// Dropped or replaced since it was reported
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return stream.Send(newTransaction(tx))
+	})
+}
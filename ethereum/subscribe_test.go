@@ -0,0 +1,74 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+)
+
+func TestEventQueueOverflow(t *testing.T) {
+	mux := new(event.TypeMux)
+	queue := newEventQueue(mux.Subscribe(0))
+
+	// Posting must never wait for the subscriber
+	posted := make(chan struct{})
+	go func() {
+		for i := 0; i <= 2*eventQueueSize; i++ {
+			mux.Post(i)
+		}
+		close(posted)
+	}()
+	select {
+	case <-posted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("posting blocked on a slow subscriber")
+	}
+
+	received := 0
+	for range queue.C {
+		received++
+	}
+	if received != eventQueueSize {
+		t.Errorf("received events mismatch: have %d, want %d", received, eventQueueSize)
+	}
+	if err := queue.err(); err != errSlowSubscriber {
+		t.Errorf("error mismatch: have %v, want %v", err, errSlowSubscriber)
+	}
+}
+
+func TestEventQueueUnsubscribe(t *testing.T) {
+	mux := new(event.TypeMux)
+	sub := mux.Subscribe(0)
+	queue := newEventQueue(sub)
+
+	mux.Post(1)
+	sub.Unsubscribe()
+
+	var received []interface{}
+	for ev := range queue.C {
+		received = append(received, ev.Data)
+	}
+	if len(received) != 1 || received[0] != 1 {
+		t.Errorf("received events mismatch: have %v, want [1]", received)
+	}
+	if err := queue.err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2017 The Ethermis Authors
+// This file is part of Ethermis.
+//
+// Ethermis is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Ethermis is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with Ethermis. If not, see <http://www.gnu.org/licenses/>.
+
+package ethereum
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/logger"
+	"github.com/ethereum/go-ethereum/logger/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/alanchchen/ethermis/api/ethereum"
+)
+
+var errUnknownMethod = errors.New("no method matches the input data")
+
+func (c *ethereumController) GetTransaction(ctx context.Context, req *ethereum.TransactionRequest) (*ethereum.Transaction, error) {
+	hash, parsedABI, err := parseTransactionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, transactionError(hash, err)
+	}
+	if parsedABI == nil && tx.To() != nil {
+		parsedABI = c.registeredABI(*tx.To())
+	}
+	blockHash, number, err := c.backend.TransactionBlock(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	t := newTransaction(tx)
+	if blockHash != (common.Hash{}) {
+		t.BlockNumber, t.BlockHash = number, blockHash.Hex()
+	}
+
+	// Creation input is the code followed by the constructor arguments,
+	// which cannot be told apart, so only method calls are decoded
+	if parsedABI != nil && tx.To() != nil {
+		method, args, err := decodeInput(parsedABI, tx.Data())
+		if err != nil {
+			glog.V(logger.Debug).Infof("Failed to decode input of %s: %v", hash.Hex(), err)
+		}
+		t.Method, t.Args = method, args
+	}
+	return t, nil
+}
+
+func (c *ethereumController) GetTransactionReceipt(ctx context.Context, req *ethereum.TransactionRequest) (*ethereum.Receipt, error) {
+	hash, parsedABI, err := parseTransactionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, transactionError(hash, err)
+	}
+	receipt, err := c.backend.TransactionReceipt(ctx, hash)
+	if receipt == nil && (err == nil || err == goethereum.NotFound) {
+		return nil, grpc.Errorf(codes.NotFound, "transaction %s is pending", hash.Hex())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedABI == nil {
+		address := receipt.ContractAddress
+		if tx.To() != nil {
+			address = *tx.To()
+		}
+		parsedABI = c.registeredABI(address)
+	}
+	return c.receipt(ctx, tx, receipt, parsedABI)
+}
+
+// registeredABI returns the ABI of a registered contract, or nil if it is
+// unknown.
+func (c *ethereumController) registeredABI(address common.Address) *abi.ABI {
+	record, err := c.registry.get(address)
+	if err != nil {
+		return nil
+	}
+	parsedABI, err := abi.JSON(strings.NewReader(record.Abi))
+	if err != nil {
+		return nil
+	}
+	return &parsedABI
+}
+
+// newTransaction converts a transaction, leaving the block it was included
+// in and the decoded input to the caller.
+func newTransaction(tx *types.Transaction) *ethereum.Transaction {
+	t := &ethereum.Transaction{
+		TransactionId: tx.Hash().Hex(),
+		Value:         tx.Value().String(),
+		Nonce:         tx.Nonce(),
+		Gas:           tx.Gas().Uint64(),
+		GasPrice:      tx.GasPrice().String(),
+		Input:         common.ToHex(tx.Data()),
+	}
+	if from, err := txSender(tx); err == nil {
+		t.From = from.Hex()
+	}
+	if tx.To() != nil {
+		t.To = tx.To().Hex()
+	}
+	return t
+}
+
+// receipt converts the receipt of a mined transaction, along with the block
+// it was included in.
+func (c *ethereumController) receipt(ctx context.Context, tx *types.Transaction, receipt *types.Receipt, parsedABI *abi.ABI) (*ethereum.Receipt, error) {
+	r := newReceipt(tx, receipt, parsedABI)
+
+	blockHash, number, err := c.backend.TransactionBlock(ctx, receipt.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if blockHash != (common.Hash{}) {
+		r.BlockNumber, r.BlockHash = number, blockHash.Hex()
+	}
+	return r, nil
+}
+
+// parseTransactionRequest validates the transaction hash and the optional
+// ABI of a lookup request.
+func parseTransactionRequest(req *ethereum.TransactionRequest) (common.Hash, *abi.ABI, error) {
+	data, err := decodeHex(req.TransactionId)
+	if err != nil || len(data) != common.HashLength {
+		return common.Hash{}, nil, grpc.Errorf(codes.InvalidArgument, "invalid transaction id %q", req.TransactionId)
+	}
+	if req.Abi == "" {
+		return common.BytesToHash(data), nil, nil
+	}
+
+	parsedABI, err := parseABI(req.Abi)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	return common.BytesToHash(data), &parsedABI, nil
+}
+
+// transactionError maps an unknown transaction to NotFound.
+func transactionError(hash common.Hash, err error) error {
+	if err == goethereum.NotFound {
+		return grpc.Errorf(codes.NotFound, "transaction %s not found", hash.Hex())
+	}
+	return err
+}
+
+// txSender recovers the sender of a transaction, with or without replay
+// protection.
+func txSender(tx *types.Transaction) (common.Address, error) {
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.NewEIP155Signer(tx.ChainId())
+	}
+	return types.Sender(signer, tx)
+}
+
+// decodeInput looks up the method matching the selector of a call's input
+// data and decodes its arguments.
+func decodeInput(parsedABI *abi.ABI, data []byte) (string, []*ethereum.Value, error) {
+	if len(data) < 4 {
+		return "", nil, errUnknownMethod
+	}
+	for name, method := range parsedABI.Methods {
+		if !bytes.Equal(method.Id(), data[:4]) {
+			continue
+		}
+		args, err := unpackArgs(method.Inputs, data[4:])
+		if err != nil {
+			return name, nil, err
+		}
+		values, err := toValues(method.Inputs, args)
+		return name, values, err
+	}
+	return "", nil, errUnknownMethod
+}
@@ -22,8 +22,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
-	"os"
-	"os/signal"
 	"strconv"
 	"strings"
 
@@ -72,24 +70,28 @@ func init() {
 	// `
 }
 
-func StartNode(stack *node.Node) {
+// StartNode starts the protocol stack and makes sure its Ethereum service is
+// up and running. Stopping the stack is left to the caller, so it can be
+// shut down after the services built on top of it. On failure the stack is
+// left stopped.
+func StartNode(stack *node.Node) error {
 	if err := stack.Start(); err != nil {
-		glog.Fatalf("Error starting protocol stack: %v", err)
-	}
-	go func() {
-		sigc := make(chan os.Signal, 1)
-		signal.Notify(sigc, os.Interrupt)
-		defer signal.Stop(sigc)
-		// <-sigc
-		glog.Infoln("Got interrupt, shutting down...")
-		go stack.Stop()
-		for i := 10; i > 0; i-- {
-			<-sigc
-			if i > 1 {
-				glog.Infof("Already shutting down, interrupt %d more times for panic.", i-1)
-			}
-		}
-	}()
+		return fmt.Errorf("error starting protocol stack: %v", err)
+	}
+
+	var err error
+	if lightMode {
+		var lesServ *les.LightEthereum
+		err = stack.Service(&lesServ)
+	} else {
+		var ethServ *Backend
+		err = stack.Service(&ethServ)
+	}
+	if err != nil {
+		stack.Stop()
+		return fmt.Errorf("ethereum service not available: %v", err)
+	}
+	return nil
 }
 
 // MakeDataDir retrieves the currently requested data directory, terminating